@@ -23,9 +23,15 @@ type StaircaseOptions struct {
 	DowngradeCmd           string   `json:"downgrade"`
 	PostgresURL            string   `json:"postgres-url"`
 	MigrationsExtension    string   `json:"migrations-extension"`
+	ColumnOrder            string   `json:"column-order"`
 	Schemas                []string `json:"schemas"`
+	ExcludeSchemas         []string `json:"exclude-schemas"`
 	Depth                  int      `json:"depth"`
 	CompareSchemaSnapshots bool     `json:"compare-snapshots"`
+	CompareSequenceState   bool     `json:"test-sequence-state"`
+	AllSchemas             bool     `json:"all-schemas"`
+	CompareClusterObjects  bool     `json:"test-cluster-objects"`
+	CompareSettings        bool     `json:"test-settings"`
 }
 
 func main() {
@@ -80,6 +86,12 @@ func invalidateOptions(opts *StaircaseOptions) func(*cobra.Command, []string) er
 		if opts.PostgresURL == "" {
 			return seqwall.ErrPostgresURLRequired()
 		}
+		if opts.ColumnOrder == "" {
+			opts.ColumnOrder = seqwall.ColumnOrderError
+		}
+		if opts.ColumnOrder != seqwall.ColumnOrderError && opts.ColumnOrder != seqwall.ColumnOrderWarning {
+			return seqwall.ErrInvalidColumnOrderMode()
+		}
 		return nil
 	}
 }
@@ -95,6 +107,14 @@ func staircaseRun(opts *StaircaseOptions) func(*cobra.Command, []string) error {
 			opts.PostgresURL,
 			opts.Schemas,
 			opts.MigrationsExtension,
+			seqwall.WorkerOptions{
+				CompareSequenceState:  opts.CompareSequenceState,
+				ColumnOrderMode:       opts.ColumnOrder,
+				AllSchemas:            opts.AllSchemas,
+				ExcludeSchemas:        opts.ExcludeSchemas,
+				CompareClusterObjects: opts.CompareClusterObjects,
+				CompareSettings:       opts.CompareSettings,
+			},
 		)
 		return worker.Run()
 	}
@@ -106,9 +126,15 @@ func bindStaircaseFlags(cmd *cobra.Command, opts *StaircaseOptions) {
 	cmd.Flags().StringVar(&opts.UpgradeCmd, "upgrade", "", "")
 	cmd.Flags().StringVar(&opts.DowngradeCmd, "downgrade", "", "")
 	cmd.Flags().BoolVar(&opts.CompareSchemaSnapshots, "test-snapshots", true, "")
+	cmd.Flags().BoolVar(&opts.CompareSequenceState, "test-sequence-state", false, "")
+	cmd.Flags().BoolVar(&opts.CompareClusterObjects, "test-cluster-objects", false, "")
+	cmd.Flags().BoolVar(&opts.CompareSettings, "test-settings", false, "")
 	cmd.Flags().StringArrayVar(&opts.Schemas, "schema", []string{"public"}, "")
+	cmd.Flags().BoolVar(&opts.AllSchemas, "all-schemas", false, "")
+	cmd.Flags().StringArrayVar(&opts.ExcludeSchemas, "exclude-schema", nil, "")
 	cmd.Flags().IntVar(&opts.Depth, "depth", 0, "")
 	cmd.Flags().StringVar(&opts.MigrationsExtension, "migrations-extension", ".sql", "")
+	cmd.Flags().StringVar(&opts.ColumnOrder, "column-order", seqwall.ColumnOrderError, "")
 }
 
 func markRequired(cmd *cobra.Command, names ...string) {
@@ -34,86 +34,432 @@ type ColumnDefinition struct {
 	CharacterMaximumLength sql.NullInt64  `db:"character_maximum_length" json:"character_maximum_length"`
 	NumericPrecision       sql.NullInt64  `db:"numeric_precision"        json:"numeric_precision"`
 	NumericScale           sql.NullInt64  `db:"numeric_scale"            json:"numeric_scale"`
+	Comment                sql.NullString `db:"comment"                  json:"comment"`
+	Storage                string         `db:"storage"                  json:"storage"`
+	StatisticsTarget       sql.NullInt64  `db:"statistics_target"        json:"statistics_target"`
+	Compression            sql.NullString `db:"compression"              json:"compression"`
+	Position               int            `db:"position"                 json:"position"`
 }
 
 type TableDefinition struct {
-	Columns []ColumnDefinition `db:"columns" json:"columns"`
+	Columns          []ColumnDefinition `db:"columns"            json:"columns"`
+	RowSecurity      bool               `db:"row_security"       json:"row_security"`
+	ForceRowSecurity bool               `db:"force_row_security" json:"force_row_security"`
+	Comment          sql.NullString     `db:"comment"            json:"comment"`
+	Persistence      string             `db:"persistence"        json:"persistence"`
+	Options          []string           `db:"options"            json:"options"`
+	Tablespace       sql.NullString     `db:"tablespace"         json:"tablespace"`
+	ReplicaIdentity  string             `db:"replica_identity"   json:"replica_identity"`
+	Owner            string             `db:"owner"              json:"owner"`
+	PartitionKey     sql.NullString     `db:"partition_key"      json:"partition_key"` // e.g. "RANGE (created_at)"
+	IsPartition      bool               `db:"is_partition"       json:"is_partition"`
+	PartitionBound   sql.NullString     `db:"partition_bound"    json:"partition_bound"`
+	Parents          []string           `db:"parents"            json:"parents"` // INHERITS and partition parents
 }
 
 type ViewDefinition struct {
-	Definition string `db:"definition" json:"definition"`
+	Definition string         `db:"definition" json:"definition"`
+	Comment    sql.NullString `db:"comment"    json:"comment"`
+	Owner      string         `db:"owner"      json:"owner"`
 }
 
 type IndexDefinition struct {
-	IndexDef string `db:"index_def" json:"index_def"`
+	TableName         string         `db:"table_name"          json:"table_name"`
+	IndexDef          string         `db:"index_def"           json:"index_def"`
+	IsValid           bool           `db:"is_valid"            json:"is_valid"`
+	IsClustered       bool           `db:"is_clustered"        json:"is_clustered"`
+	IsReplicaIdentity bool           `db:"is_replica_identity" json:"is_replica_identity"`
+	Comment           sql.NullString `db:"comment"             json:"comment"`
 }
 
 type ConstraintDefinition struct {
-	TableName      string         `db:"table_name"      json:"table_name"`
-	ConstraintType string         `db:"constraint_type" json:"constraint_type"`
-	Definition     sql.NullString `db:"definition"      json:"definition"`
+	TableSchema       string         `db:"table_schema"       json:"table_schema"`
+	TableName         string         `db:"table_name"         json:"table_name"`
+	ConstraintType    string         `db:"constraint_type"    json:"constraint_type"`
+	Definition        sql.NullString `db:"definition"         json:"definition"`
+	Deferrable        bool           `db:"deferrable"         json:"deferrable"`
+	InitiallyDeferred bool           `db:"initially_deferred" json:"initially_deferred"`
+	Validated         bool           `db:"validated"          json:"validated"`
+	Comment           sql.NullString `db:"comment"            json:"comment"`
 }
 
 type EnumDefinition struct {
-	Labels []string `db:"labels" json:"labels"`
+	Labels  []string       `db:"labels"  json:"labels"`
+	Comment sql.NullString `db:"comment" json:"comment"`
+	Owner   string         `db:"owner"   json:"owner"`
+}
+
+// DomainDefinition describes a CREATE DOMAIN type; Constraints maps constraint
+// names to their pg_get_constraintdef output.
+type DomainDefinition struct {
+	BaseType    string            `db:"base_type"   json:"base_type"`
+	NotNull     bool              `db:"not_null"    json:"not_null"`
+	Default     sql.NullString    `db:"default"     json:"default"`
+	Collation   sql.NullString    `db:"collation"   json:"collation"`
+	Constraints map[string]string `db:"constraints" json:"constraints"`
+	Comment     sql.NullString    `db:"comment"     json:"comment"`
+	Owner       string            `db:"owner"       json:"owner"`
+}
+
+type CompositeAttribute struct {
+	Name      string         `db:"name"      json:"name"`
+	DataType  string         `db:"data_type" json:"data_type"`
+	Collation sql.NullString `db:"collation" json:"collation"`
+}
+
+type CompositeTypeDefinition struct {
+	Attributes []CompositeAttribute `db:"attributes" json:"attributes"`
+	Comment    sql.NullString       `db:"comment"    json:"comment"`
+	Owner      string               `db:"owner"      json:"owner"`
+}
+
+type RangeTypeDefinition struct {
+	Subtype        string         `db:"subtype"         json:"subtype"`
+	Collation      sql.NullString `db:"collation"       json:"collation"`
+	SubtypeOpClass string         `db:"subtype_opclass" json:"subtype_opclass"`
+	Canonical      sql.NullString `db:"canonical"       json:"canonical"`
+	SubtypeDiff    sql.NullString `db:"subtype_diff"    json:"subtype_diff"`
+	Comment        sql.NullString `db:"comment"         json:"comment"`
+	Owner          string         `db:"owner"           json:"owner"`
 }
 
+// ForeignKeyDefinition holds ordered column lists on both sides of the reference.
 type ForeignKeyDefinition struct {
-	ConstraintName    string `db:"constraint_name"     json:"constraint_name"`
-	TableName         string `db:"table_name"          json:"table_name"`
-	ColumnName        string `db:"column_name"         json:"column_name"`
-	ForeignTableName  string `db:"foreign_table_name"  json:"foreign_table_name"`
-	ForeignColumnName string `db:"foreign_column_name" json:"foreign_column_name"`
-	UpdateRule        string `db:"update_rule"         json:"update_rule"`
-	DeleteRule        string `db:"delete_rule"         json:"delete_rule"`
+	ConstraintName     string   `db:"constraint_name"      json:"constraint_name"`
+	TableSchema        string   `db:"table_schema"         json:"table_schema"`
+	TableName          string   `db:"table_name"           json:"table_name"`
+	Columns            []string `db:"columns"              json:"columns"`
+	ForeignTableSchema string   `db:"foreign_table_schema" json:"foreign_table_schema"`
+	ForeignTableName   string   `db:"foreign_table_name"   json:"foreign_table_name"`
+	ForeignColumns     []string `db:"foreign_columns"      json:"foreign_columns"`
+	MatchType          string   `db:"match_type"           json:"match_type"`
+	UpdateRule         string   `db:"update_rule"          json:"update_rule"`
+	DeleteRule         string   `db:"delete_rule"          json:"delete_rule"`
+	Deferrable         bool     `db:"deferrable"           json:"deferrable"`
+	InitiallyDeferred  bool     `db:"initially_deferred"   json:"initially_deferred"`
 }
 
+// TriggerDefinition is keyed by schema.table.name; Definition is the full CREATE TRIGGER statement.
 type TriggerDefinition struct {
-	TriggerName       string `db:"trigger_name"       json:"trigger_name"`
-	EventManipulation string `db:"event_manipulation" json:"event_manipulation"`
-	EventObjectTable  string `db:"event_object_table" json:"event_object_table"`
-	ActionTiming      string `db:"action_timing"      json:"action_timing"`
-	ActionStatement   string `db:"action_statement"   json:"action_statement"`
+	TriggerName string         `db:"trigger_name" json:"trigger_name"`
+	TableName   string         `db:"table_name"   json:"table_name"`
+	Definition  string         `db:"definition"   json:"definition"`
+	Enabled     string         `db:"enabled"      json:"enabled"`
+	Comment     sql.NullString `db:"comment"      json:"comment"`
 }
 
+// FunctionDefinition describes a single routine overload, keyed by schema.name(identity arguments).
+// Definition holds the normalized CREATE statement from pg_get_functiondef; aggregates live in Aggregates.
 type FunctionDefinition struct {
-	RoutineName string `db:"routine_name" json:"routine_name"`
-	RoutineType string `db:"routine_type" json:"routine_type"`
-	ReturnType  string `db:"return_type"  json:"return_type"`
+	RoutineName       string         `db:"routine_name"       json:"routine_name"`
+	RoutineType       string         `db:"routine_type"       json:"routine_type"`
+	IdentityArguments string         `db:"identity_arguments" json:"identity_arguments"`
+	ReturnType        string         `db:"return_type"        json:"return_type"`
+	Definition        sql.NullString `db:"definition"         json:"definition"`
+	Comment           sql.NullString `db:"comment"            json:"comment"`
+	Owner             string         `db:"owner"              json:"owner"`
+	Volatility        string         `db:"volatility"         json:"volatility"`
+	SecurityDefiner   bool           `db:"security_definer"   json:"security_definer"`
+	LeakProof         bool           `db:"leak_proof"         json:"leak_proof"`
+	Parallel          string         `db:"parallel"           json:"parallel"`
+	Config            []string       `db:"config"             json:"config"`
+	Language          string         `db:"language"           json:"language"`
+	Cost              float64        `db:"cost"               json:"cost"`
 }
 
+// SequenceDefinition is keyed by schema.name. OwnedBy is the schema.table.column the sequence is
+// attached to; LastValue and IsCalled are read from the sequence relation only with --test-sequence-state.
 type SequenceDefinition struct {
-	SequenceName string `db:"sequence_name" json:"sequence_name"`
-	DataType     string `db:"data_type"     json:"data_type"`
-	StartValue   string `db:"start_value"   json:"start_value"`
-	MinValue     string `db:"min_value"     json:"min_value"`
-	MaxValue     string `db:"max_value"     json:"max_value"`
-	Increment    string `db:"increment"     json:"increment"`
-	CycleOption  string `db:"cycle_option"  json:"cycle_option"`
+	SequenceName string         `db:"sequence_name" json:"sequence_name"`
+	DataType     string         `db:"data_type"     json:"data_type"`
+	StartValue   string         `db:"start_value"   json:"start_value"`
+	MinValue     string         `db:"min_value"     json:"min_value"`
+	MaxValue     string         `db:"max_value"     json:"max_value"`
+	Increment    string         `db:"increment"     json:"increment"`
+	CycleOption  string         `db:"cycle_option"  json:"cycle_option"`
+	Comment      sql.NullString `db:"comment"       json:"comment"`
+	Owner        string         `db:"owner"         json:"owner"`
+	OwnedBy      sql.NullString `db:"owned_by"      json:"owned_by"`
+	LastValue    sql.NullInt64  `db:"last_value"    json:"last_value"`
+	IsCalled     sql.NullBool   `db:"is_called"     json:"is_called"`
 }
 
 type MatViewDefinition struct {
-	Definition  string `db:"definition"   json:"definition"`
-	IsPopulated bool   `db:"is_populated" json:"is_populated"`
+	Definition  string         `db:"definition"   json:"definition"`
+	IsPopulated bool           `db:"is_populated" json:"is_populated"`
+	Comment     sql.NullString `db:"comment"      json:"comment"`
+	Owner       string         `db:"owner"        json:"owner"`
 }
 
+// PrivilegeDefinition is a single exploded ACL entry. ObjectName is schema-qualified
+// for schema objects and "schema.table.column" for column-level grants.
 type PrivilegeDefinition struct {
+	ObjectType  string `db:"object_type"    json:"object_type"`
+	ObjectName  string `db:"object_name"    json:"object_name"`
+	Grantor     string `db:"grantor"        json:"grantor"`
+	Grantee     string `db:"grantee"        json:"grantee"`
+	Privilege   string `db:"privilege_type" json:"privilege_type"`
+	IsGrantable string `db:"is_grantable"   json:"is_grantable"`
+}
+
+// DefaultPrivilegeDefinition is a single ALTER DEFAULT PRIVILEGES entry; an empty
+// Schema means the default applies to all schemas.
+type DefaultPrivilegeDefinition struct {
+	Role        string `db:"role_name"      json:"role_name"`
+	Schema      string `db:"schema_name"    json:"schema_name"`
+	ObjectType  string `db:"object_type"    json:"object_type"`
 	Grantee     string `db:"grantee"        json:"grantee"`
-	TableName   string `db:"table_name"     json:"table_name"`
 	Privilege   string `db:"privilege_type" json:"privilege_type"`
 	IsGrantable string `db:"is_grantable"   json:"is_grantable"`
 }
 
+type PolicyDefinition struct {
+	TableName  string         `db:"table_name" json:"table_name"`
+	Permissive string         `db:"permissive" json:"permissive"`
+	Roles      []string       `db:"roles"      json:"roles"`
+	Command    string         `db:"cmd"        json:"cmd"`
+	Using      sql.NullString `db:"qual"       json:"qual"`
+	WithCheck  sql.NullString `db:"with_check" json:"with_check"`
+	Comment    sql.NullString `db:"comment"    json:"comment"`
+}
+
+type ExtensionDefinition struct {
+	Version string `db:"version" json:"version"`
+	Schema  string `db:"schema"  json:"schema"`
+}
+
+type EventTriggerDefinition struct {
+	Event    string   `db:"event"    json:"event"`
+	Function string   `db:"function" json:"function"`
+	Enabled  string   `db:"enabled"  json:"enabled"`
+	Tags     []string `db:"tags"     json:"tags"`
+	Owner    string   `db:"owner"    json:"owner"`
+}
+
+type RuleDefinition struct {
+	TableName  string `db:"table_name" json:"table_name"`
+	Definition string `db:"definition" json:"definition"`
+	Enabled    string `db:"enabled"    json:"enabled"`
+}
+
+// PublicationTableDefinition holds the PostgreSQL 15+ row filter and column list
+// of a publication member; both are NULL on older servers.
+type PublicationTableDefinition struct {
+	RowFilter sql.NullString `db:"row_filter" json:"row_filter"`
+	Columns   []string       `db:"columns"    json:"columns"`
+}
+
+// PublicationDefinition describes a publication; Tables is keyed by "schema.table".
+type PublicationDefinition struct {
+	Owner     string                                `db:"owner"      json:"owner"`
+	AllTables bool                                  `db:"all_tables" json:"all_tables"`
+	Insert    bool                                  `db:"insert"     json:"insert"`
+	Update    bool                                  `db:"update"     json:"update"`
+	Delete    bool                                  `db:"delete"     json:"delete"`
+	Truncate  bool                                  `db:"truncate"   json:"truncate"`
+	ViaRoot   bool                                  `db:"via_root"   json:"via_root"`
+	Tables    map[string]PublicationTableDefinition `db:"tables"     json:"tables"`
+	Schemas   []string                              `db:"schemas"    json:"schemas"`
+}
+
+type StatisticsDefinition struct {
+	TableName        string        `db:"table_name"        json:"table_name"`
+	Definition       string        `db:"definition"        json:"definition"`
+	StatisticsTarget sql.NullInt64 `db:"statistics_target" json:"statistics_target"`
+	Owner            string        `db:"owner"             json:"owner"`
+}
+
+type CollationDefinition struct {
+	Provider      string         `db:"provider"      json:"provider"`
+	Deterministic bool           `db:"deterministic" json:"deterministic"`
+	Collate       sql.NullString `db:"collate"       json:"collate"`
+	Ctype         sql.NullString `db:"ctype"         json:"ctype"`
+	Locale        sql.NullString `db:"locale"        json:"locale"`
+	Owner         string         `db:"owner"         json:"owner"`
+}
+
+// TextSearchConfigDefinition maps each token type alias to its ordered dictionaries.
+type TextSearchConfigDefinition struct {
+	Parser   string              `db:"parser"   json:"parser"`
+	Mappings map[string][]string `db:"mappings" json:"mappings"`
+	Owner    string              `db:"owner"    json:"owner"`
+}
+
+type TextSearchDictDefinition struct {
+	Template string         `db:"template" json:"template"`
+	Options  sql.NullString `db:"options"  json:"options"`
+	Owner    string         `db:"owner"    json:"owner"`
+}
+
+type ForeignDataWrapperDefinition struct {
+	Handler   sql.NullString `db:"handler"   json:"handler"`
+	Validator sql.NullString `db:"validator" json:"validator"`
+	Options   []string       `db:"options"   json:"options"`
+	Owner     string         `db:"owner"     json:"owner"`
+}
+
+type ForeignServerDefinition struct {
+	Wrapper string         `db:"wrapper" json:"wrapper"`
+	Type    sql.NullString `db:"type"    json:"type"`
+	Version sql.NullString `db:"version" json:"version"`
+	Options []string       `db:"options" json:"options"`
+	Owner   string         `db:"owner"   json:"owner"`
+}
+
+type UserMappingDefinition struct {
+	Options []string `db:"options" json:"options"`
+}
+
+// ForeignTableDefinition holds table-level options and per-column options
+// of a foreign table; its columns are captured in Tables like any other relation.
+type ForeignTableDefinition struct {
+	Server        string              `db:"server"         json:"server"`
+	Options       []string            `db:"options"        json:"options"`
+	ColumnOptions map[string][]string `db:"column_options" json:"column_options"`
+}
+
+type SchemaDefinition struct {
+	Owner   string         `db:"owner"   json:"owner"`
+	ACL     []string       `db:"acl"     json:"acl"`
+	Comment sql.NullString `db:"comment" json:"comment"`
+}
+
+// AggregateDefinition is keyed like functions, by schema.name(identity arguments).
+type AggregateDefinition struct {
+	Kind               string         `db:"kind"                json:"kind"`
+	TransitionFunction string         `db:"transition_function" json:"transition_function"`
+	FinalFunction      sql.NullString `db:"final_function"      json:"final_function"`
+	CombineFunction    sql.NullString `db:"combine_function"    json:"combine_function"`
+	SortOperator       sql.NullString `db:"sort_operator"       json:"sort_operator"`
+	StateType          string         `db:"state_type"          json:"state_type"`
+	InitialValue       sql.NullString `db:"initial_value"       json:"initial_value"`
+	ReturnType         string         `db:"return_type"         json:"return_type"`
+	Comment            sql.NullString `db:"comment"             json:"comment"`
+	Owner              string         `db:"owner"               json:"owner"`
+}
+
+// OperatorDefinition is keyed by schema.name(left type, right type); a missing operand is NONE.
+type OperatorDefinition struct {
+	LeftType   string         `db:"left_type"   json:"left_type"`
+	RightType  string         `db:"right_type"  json:"right_type"`
+	ResultType string         `db:"result_type" json:"result_type"`
+	Function   sql.NullString `db:"function"    json:"function"`
+	Commutator sql.NullString `db:"commutator"  json:"commutator"`
+	Negator    sql.NullString `db:"negator"     json:"negator"`
+	Restrict   sql.NullString `db:"restrict"    json:"restrict"`
+	Join       sql.NullString `db:"join"        json:"join"`
+	CanHash    bool           `db:"can_hash"    json:"can_hash"`
+	CanMerge   bool           `db:"can_merge"   json:"can_merge"`
+	Comment    sql.NullString `db:"comment"     json:"comment"`
+	Owner      string         `db:"owner"       json:"owner"`
+}
+
+// CastDefinition is keyed by "source AS target".
+type CastDefinition struct {
+	Function sql.NullString `db:"function" json:"function"`
+	Context  string         `db:"context"  json:"context"`
+	Method   string         `db:"method"   json:"method"`
+	Comment  sql.NullString `db:"comment"  json:"comment"`
+}
+
+// OperatorFamilyDefinition is keyed by "schema.name USING method"; members are rendered
+// like ALTER OPERATOR FAMILY ... ADD items.
+type OperatorFamilyDefinition struct {
+	Method    string   `db:"method"    json:"method"`
+	Operators []string `db:"operators" json:"operators"`
+	Functions []string `db:"functions" json:"functions"`
+	Owner     string   `db:"owner"     json:"owner"`
+}
+
+// OperatorClassDefinition is keyed by "schema.name USING method".
+type OperatorClassDefinition struct {
+	Method      string         `db:"method"       json:"method"`
+	Family      string         `db:"family"       json:"family"`
+	InputType   string         `db:"input_type"   json:"input_type"`
+	StorageType sql.NullString `db:"storage_type" json:"storage_type"`
+	IsDefault   bool           `db:"is_default"   json:"is_default"`
+	Owner       string         `db:"owner"        json:"owner"`
+}
+
+// RoleDefinition describes a cluster-wide role; Config holds ALTER ROLE ... SET entries for all databases.
+type RoleDefinition struct {
+	Superuser       bool           `db:"superuser"        json:"superuser"`
+	Inherit         bool           `db:"inherit"          json:"inherit"`
+	CreateRole      bool           `db:"create_role"      json:"create_role"`
+	CreateDB        bool           `db:"create_db"        json:"create_db"`
+	CanLogin        bool           `db:"can_login"        json:"can_login"`
+	Replication     bool           `db:"replication"      json:"replication"`
+	BypassRLS       bool           `db:"bypass_rls"       json:"bypass_rls"`
+	ConnectionLimit int            `db:"connection_limit" json:"connection_limit"`
+	ValidUntil      sql.NullString `db:"valid_until"      json:"valid_until"`
+	Config          []string       `db:"config"           json:"config"`
+}
+
+// RoleMembershipDefinition is keyed by role.member.grantor; InheritOption and SetOption are NULL before PostgreSQL 16.
+type RoleMembershipDefinition struct {
+	Role          string       `db:"role"           json:"role"`
+	Member        string       `db:"member"         json:"member"`
+	Grantor       string       `db:"grantor"        json:"grantor"`
+	AdminOption   bool         `db:"admin_option"   json:"admin_option"`
+	InheritOption sql.NullBool `db:"inherit_option" json:"inherit_option"`
+	SetOption     sql.NullBool `db:"set_option"     json:"set_option"`
+}
+
+type TablespaceDefinition struct {
+	Owner    string   `db:"owner"    json:"owner"`
+	Location string   `db:"location" json:"location"`
+	Options  []string `db:"options"  json:"options"`
+}
+
+// SettingDefinition holds one pg_db_role_setting entry of the tested database; a NULL Role applies to all roles.
+type SettingDefinition struct {
+	Database sql.NullString `db:"database" json:"database"`
+	Role     sql.NullString `db:"role"     json:"role"`
+	Config   []string       `db:"config"   json:"config"`
+}
+
+// SchemaSnapshot keys every object by its schema-qualified name ("schema.name");
+// table-scoped objects (constraints, foreign keys, triggers, policies, rules) use "schema.table.name".
+// Database-wide objects (extensions, event triggers, publications, foreign data wrappers and servers)
+// are keyed by their bare name; user mappings use "server.user". Cluster objects (roles, tablespaces)
+// are only filled with --test-cluster-objects; settings (--test-settings) use "database.role" with "*" for all.
 type SchemaSnapshot struct {
-	Tables      map[string]TableDefinition      `db:"tables"       json:"tables"`
-	Views       map[string]ViewDefinition       `db:"views"        json:"views"`
-	MatViews    map[string]MatViewDefinition    `db:"matviews"     json:"matviews"`
-	Indexes     map[string]IndexDefinition      `db:"indexes"      json:"indexes"`
-	Constraints map[string]ConstraintDefinition `db:"constraints"  json:"constraints"`
-	EnumTypes   map[string]EnumDefinition       `db:"enum_types"   json:"enum_types"`
-	ForeignKeys map[string]ForeignKeyDefinition `db:"foreign_keys" json:"foreign_keys"`
-	Triggers    map[string]TriggerDefinition    `db:"triggers"     json:"triggers"`
-	Functions   map[string]FunctionDefinition   `db:"functions"    json:"functions"`
-	Sequences   map[string]SequenceDefinition   `db:"sequences"    json:"sequences"`
-	Privileges  []PrivilegeDefinition           `db:"privileges"   json:"privileges"`
+	Schemas             map[string]SchemaDefinition             `db:"schemas"               json:"schemas"`
+	Tables              map[string]TableDefinition              `db:"tables"                json:"tables"`
+	Views               map[string]ViewDefinition               `db:"views"                 json:"views"`
+	MatViews            map[string]MatViewDefinition            `db:"matviews"              json:"matviews"`
+	Indexes             map[string]IndexDefinition              `db:"indexes"               json:"indexes"`
+	Constraints         map[string]ConstraintDefinition         `db:"constraints"           json:"constraints"`
+	EnumTypes           map[string]EnumDefinition               `db:"enum_types"            json:"enum_types"`
+	DomainTypes         map[string]DomainDefinition             `db:"domain_types"          json:"domain_types"`
+	CompositeTypes      map[string]CompositeTypeDefinition      `db:"composite_types"       json:"composite_types"`
+	RangeTypes          map[string]RangeTypeDefinition          `db:"range_types"           json:"range_types"`
+	ForeignKeys         map[string]ForeignKeyDefinition         `db:"foreign_keys"          json:"foreign_keys"`
+	Triggers            map[string]TriggerDefinition            `db:"triggers"              json:"triggers"`
+	Functions           map[string]FunctionDefinition           `db:"functions"             json:"functions"`
+	Sequences           map[string]SequenceDefinition           `db:"sequences"             json:"sequences"`
+	Privileges          []PrivilegeDefinition                   `db:"privileges"            json:"privileges"`
+	DefaultPrivileges   []DefaultPrivilegeDefinition            `db:"default_privileges"    json:"default_privileges"`
+	Policies            map[string]PolicyDefinition             `db:"policies"              json:"policies"`
+	Extensions          map[string]ExtensionDefinition          `db:"extensions"            json:"extensions"`
+	EventTriggers       map[string]EventTriggerDefinition       `db:"event_triggers"        json:"event_triggers"`
+	Rules               map[string]RuleDefinition               `db:"rules"                 json:"rules"`
+	Publications        map[string]PublicationDefinition        `db:"publications"          json:"publications"`
+	Statistics          map[string]StatisticsDefinition         `db:"statistics"            json:"statistics"`
+	Collations          map[string]CollationDefinition          `db:"collations"            json:"collations"`
+	TSConfigs           map[string]TextSearchConfigDefinition   `db:"ts_configs"            json:"ts_configs"`
+	TSDicts             map[string]TextSearchDictDefinition     `db:"ts_dicts"              json:"ts_dicts"`
+	ForeignDataWrappers map[string]ForeignDataWrapperDefinition `db:"foreign_data_wrappers" json:"foreign_data_wrappers"`
+	ForeignServers      map[string]ForeignServerDefinition      `db:"foreign_servers"       json:"foreign_servers"`
+	UserMappings        map[string]UserMappingDefinition        `db:"user_mappings"         json:"user_mappings"`
+	ForeignTables       map[string]ForeignTableDefinition       `db:"foreign_tables"        json:"foreign_tables"`
+	Aggregates          map[string]AggregateDefinition          `db:"aggregates"            json:"aggregates"`
+	Operators           map[string]OperatorDefinition           `db:"operators"             json:"operators"`
+	Casts               map[string]CastDefinition               `db:"casts"                 json:"casts"`
+	OperatorFamilies    map[string]OperatorFamilyDefinition     `db:"operator_families"     json:"operator_families"`
+	OperatorClasses     map[string]OperatorClassDefinition      `db:"operator_classes"      json:"operator_classes"`
+	Roles               map[string]RoleDefinition               `db:"roles"                 json:"roles"`
+	RoleMemberships     map[string]RoleMembershipDefinition     `db:"role_memberships"      json:"role_memberships"`
+	Tablespaces         map[string]TablespaceDefinition         `db:"tablespaces"           json:"tablespaces"`
+	Settings            map[string]SettingDefinition            `db:"settings"              json:"settings"`
 }
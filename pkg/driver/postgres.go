@@ -43,6 +43,15 @@ func (p *PostgresClient) Execute(query string, args ...interface{}) (*QueryResul
 	return &QueryResult{Rows: rows}, nil
 }
 
+// ServerVersionNum returns the server_version_num setting, e.g. 150004 for PostgreSQL 15.4.
+func (p *PostgresClient) ServerVersionNum() (int, error) {
+	var version int
+	if err := p.conn.QueryRow("SELECT current_setting('server_version_num')::int").Scan(&version); err != nil {
+		return 0, err
+	}
+	return version, nil
+}
+
 func (p *PostgresClient) Close() error {
 	return p.conn.Close()
 }
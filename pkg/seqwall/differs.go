@@ -3,7 +3,9 @@ package seqwall
 import (
 	"encoding/json"
 	"fmt"
-	"regexp"
+	"slices"
+	"sort"
+	"strings"
 
 	"github.com/pmezard/go-difflib/difflib"
 	"github.com/realkarych/seqwall/pkg/driver"
@@ -29,8 +31,6 @@ func diffJSON(a, b []byte) (string, error) {
 }
 
 func compareSchemas(before, after *driver.SchemaSnapshot) error {
-	before.Constraints = normalizeConstraints(before.Constraints)
-	after.Constraints = normalizeConstraints(after.Constraints)
 	b, err := marshalSnapshot(before)
 	if err != nil {
 		return fmt.Errorf("marshal before: %w", err)
@@ -49,24 +49,62 @@ func compareSchemas(before, after *driver.SchemaSnapshot) error {
 	return nil
 }
 
-func normalizeConstraints(src map[string]driver.ConstraintDefinition) map[string]driver.ConstraintDefinition {
-	checkNullConstraintSubmatchCount := 2
-	res := make(map[string]driver.ConstraintDefinition)
-	re := regexp.MustCompile(`^([A-Za-z0-9_]+)(?:::[A-Za-z0-9_]+)?\s+IS\s+NOT\s+NULL$`)
-	for _, c := range src {
-		if c.ConstraintType == "CHECK" && c.Definition.Valid {
-			if m := re.FindStringSubmatch(c.Definition.String); len(m) == checkNullConstraintSubmatchCount {
-				k := c.TableName + "_" + m[1] + "_not_null"
-				res[k] = c
-				continue
-			}
-		}
+// columnOrderChanges reports tables present in both snapshots whose shared columns
+// appear in a different order. Added or dropped columns are left to the regular diff.
+func columnOrderChanges(before, after *driver.SchemaSnapshot) []string {
+	var changes []string
+	keys := make([]string, 0, len(before.Tables))
+	for k := range before.Tables {
+		keys = append(keys, k)
 	}
-	for k, c := range src {
-		if c.ConstraintType == "CHECK" && c.Definition.Valid && re.MatchString(c.Definition.String) {
+	sort.Strings(keys)
+	for _, k := range keys {
+		at, ok := after.Tables[k]
+		if !ok {
 			continue
 		}
-		res[k] = c
+		b := orderedColumnNames(before.Tables[k].Columns)
+		a := orderedColumnNames(at.Columns)
+		if !slices.Equal(sharedColumns(b, a), sharedColumns(a, b)) {
+			changes = append(changes, fmt.Sprintf("  %s: (%s) -> (%s)", k, strings.Join(b, ", "), strings.Join(a, ", ")))
+		}
+	}
+	return changes
+}
+
+func orderedColumnNames(cols []driver.ColumnDefinition) []string {
+	sorted := slices.Clone(cols)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Position < sorted[j].Position })
+	names := make([]string, 0, len(sorted))
+	for _, c := range sorted {
+		names = append(names, c.ColumnName)
+	}
+	return names
+}
+
+func sharedColumns(names, other []string) []string {
+	res := make([]string, 0, len(names))
+	for _, n := range names {
+		if slices.Contains(other, n) {
+			res = append(res, n)
+		}
 	}
 	return res
 }
+
+// withoutColumnOrder returns a shallow copy of snap whose table columns are sorted by
+// name with positions cleared, so a tolerated reorder does not show up in the diff.
+func withoutColumnOrder(snap *driver.SchemaSnapshot) *driver.SchemaSnapshot {
+	res := *snap
+	res.Tables = make(map[string]driver.TableDefinition, len(snap.Tables))
+	for k, td := range snap.Tables {
+		cols := slices.Clone(td.Columns)
+		for i := range cols {
+			cols[i].Position = 0
+		}
+		sort.Slice(cols, func(i, j int) bool { return cols[i].ColumnName < cols[j].ColumnName })
+		td.Columns = cols
+		res.Tables[k] = td
+	}
+	return &res
+}
@@ -59,37 +59,6 @@ func makeConstraint(table, _, consType, defStr string, valid bool) driver.Constr
 	}
 }
 
-func TestNormalizeConstraints(t *testing.T) {
-	src := map[string]driver.ConstraintDefinition{
-		"c_not_null": makeConstraint("users", "c_not_null", "CHECK", "email IS NOT NULL", true),
-		"c_check":    makeConstraint("users", "c_check", "CHECK", "age > 0", true),
-		"c_other":    makeConstraint("users", "c_other", "UNIQUE", "(id)", false),
-	}
-	res := normalizeConstraints(src)
-	newKey := "users_email_not_null"
-	if _, ok := res[newKey]; !ok {
-		t.Errorf("expected renamed constraint key %q, got keys %v", newKey, keys(res))
-	}
-	if _, ok := res["c_not_null"]; ok {
-		t.Error("original NOT NULL constraint key should be removed")
-	}
-	if _, ok := res["c_check"]; !ok {
-		t.Error("expected CHECK constraint without rename to be kept")
-	}
-	if _, ok := res["c_other"]; !ok {
-		t.Error("expected non-CHECK constraint to be kept")
-	}
-}
-
-// helper to list map keys
-func keys(m map[string]driver.ConstraintDefinition) []string {
-	res := make([]string, 0, len(m))
-	for k := range m {
-		res = append(res, k)
-	}
-	return res
-}
-
 func TestCompareSchemas_NoDifferences(t *testing.T) {
 	before := &driver.SchemaSnapshot{Constraints: map[string]driver.ConstraintDefinition{
 		"c1": makeConstraint("t1", "c1", "CHECK", "col IS NOT NULL", true),
@@ -122,16 +91,92 @@ func TestCompareSchemas_WithDifferences(t *testing.T) {
 	}
 }
 
-func TestNormalizeConstraints_WithCast(t *testing.T) {
-	src := map[string]driver.ConstraintDefinition{
-		"c_cast_not_null": makeConstraint("users", "c_cast_not_null", "CHECK", "email::text IS NOT NULL", true),
+func TestCompareSchemas_FunctionAttributes(t *testing.T) {
+	fn := driver.FunctionDefinition{
+		RoutineName:       "secrets_count",
+		RoutineType:       "FUNCTION",
+		IdentityArguments: "",
+		ReturnType:        "bigint",
+		Volatility:        "STABLE",
+		Parallel:          "UNSAFE",
+		Language:          "sql",
+		Cost:              100,
+	}
+	changed := fn
+	changed.SecurityDefiner = true
+	changed.Config = []string{"search_path=public"}
+	before := &driver.SchemaSnapshot{Functions: map[string]driver.FunctionDefinition{"public.secrets_count()": fn}}
+	after := &driver.SchemaSnapshot{Functions: map[string]driver.FunctionDefinition{"public.secrets_count()": changed}}
+	err := compareSchemas(before, after)
+	if !errors.Is(err, ErrSnapshotsDiffer()) {
+		t.Fatalf("expected ErrSnapshotsDiffer for changed function attributes, got %v", err)
 	}
-	res := normalizeConstraints(src)
-	newKey := "users_email_not_null"
-	if _, ok := res[newKey]; !ok {
-		t.Errorf("expected renamed constraint key %q for cast syntax, got keys %v", newKey, keys(res))
+	for _, want := range []string{`-      "security_definer": false`, `+      "security_definer": true`, `"search_path=public"`} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("expected diff to contain %q, got:\n%v", want, err)
+		}
+	}
+	b, err := marshalSnapshot(before)
+	if err != nil {
+		t.Fatalf("marshalSnapshot error: %v", err)
+	}
+	for _, key := range []string{"volatility", "security_definer", "leak_proof", "parallel", "config", "language", "cost"} {
+		if !strings.Contains(string(b), `"`+key+`"`) {
+			t.Errorf("expected function attribute %q in snapshot JSON", key)
+		}
+	}
+}
+
+func makeTable(cols ...string) driver.TableDefinition {
+	td := driver.TableDefinition{}
+	for i, c := range cols {
+		td.Columns = append(td.Columns, driver.ColumnDefinition{ColumnName: c, Position: i + 1})
+	}
+	return td
+}
+
+func TestColumnOrderChanges(t *testing.T) {
+	before := &driver.SchemaSnapshot{Tables: map[string]driver.TableDefinition{
+		"public.users":  makeTable("id", "name", "email"),
+		"public.orders": makeTable("id", "total"),
+		"public.gone":   makeTable("id"),
+	}}
+	after := &driver.SchemaSnapshot{Tables: map[string]driver.TableDefinition{
+		"public.users":  makeTable("id", "email", "name"),
+		"public.orders": makeTable("id", "total", "note"),
+	}}
+	changes := columnOrderChanges(before, after)
+	if len(changes) != 1 {
+		t.Fatalf("expected 1 change, got %d: %v", len(changes), changes)
+	}
+	if !strings.Contains(changes[0], "public.users") || !strings.Contains(changes[0], "(id, name, email) -> (id, email, name)") {
+		t.Errorf("unexpected change report: %q", changes[0])
+	}
+}
+
+func TestColumnOrderChanges_DroppedColumnIsNotReorder(t *testing.T) {
+	before := &driver.SchemaSnapshot{Tables: map[string]driver.TableDefinition{
+		"public.users": makeTable("id", "name", "email"),
+	}}
+	after := &driver.SchemaSnapshot{Tables: map[string]driver.TableDefinition{
+		"public.users": makeTable("id", "email"),
+	}}
+	if changes := columnOrderChanges(before, after); len(changes) != 0 {
+		t.Errorf("expected no order changes, got %v", changes)
+	}
+}
+
+func TestWithoutColumnOrder(t *testing.T) {
+	before := &driver.SchemaSnapshot{Tables: map[string]driver.TableDefinition{
+		"public.users": makeTable("id", "name", "email"),
+	}}
+	after := &driver.SchemaSnapshot{Tables: map[string]driver.TableDefinition{
+		"public.users": makeTable("id", "email", "name"),
+	}}
+	if err := compareSchemas(withoutColumnOrder(before), withoutColumnOrder(after)); err != nil {
+		t.Errorf("expected reordered columns to compare equal, got %v", err)
 	}
-	if _, ok := res["c_cast_not_null"]; ok {
-		t.Error("original constraint key should be removed for cast syntax")
+	if before.Tables["public.users"].Columns[1].ColumnName != "name" || before.Tables["public.users"].Columns[1].Position != 2 {
+		t.Error("withoutColumnOrder must not modify the original snapshot")
 	}
 }
@@ -2,6 +2,12 @@ package seqwall
 
 import "github.com/realkarych/seqwall/pkg/driver"
 
+// Accepted values of --column-order.
+const (
+	ColumnOrderError   = "error"
+	ColumnOrderWarning = "warning"
+)
+
 type Cli interface {
 	Run()
 }
@@ -14,9 +20,26 @@ type StaircaseWorker struct {
 	downgradeCmd           string
 	postgresURL            string
 	migrationsExtension    string
+	columnOrderMode        string
 	schemas                []string
+	excludeSchemas         []string
 	depth                  int
+	serverVersion          int
 	compareSchemaSnapshots bool
+	compareSequenceState   bool
+	allSchemas             bool
+	compareClusterObjects  bool
+	compareSettings        bool
+}
+
+// WorkerOptions holds the opt-in checks and schema selection of a staircase run.
+type WorkerOptions struct {
+	CompareSequenceState  bool
+	ColumnOrderMode       string
+	AllSchemas            bool
+	ExcludeSchemas        []string
+	CompareClusterObjects bool
+	CompareSettings       bool
 }
 
 func NewStaircaseWorker(
@@ -28,6 +51,7 @@ func NewStaircaseWorker(
 	postgresURL string,
 	schemas []string,
 	migrationsExtension string,
+	opts WorkerOptions,
 ) *StaircaseWorker {
 	return &StaircaseWorker{
 		migrationsPath:         migrationsPath,
@@ -39,5 +63,11 @@ func NewStaircaseWorker(
 		baseline:               make(map[string]*driver.SchemaSnapshot),
 		schemas:                schemas,
 		migrationsExtension:    migrationsExtension,
+		compareSequenceState:   opts.CompareSequenceState,
+		columnOrderMode:        opts.ColumnOrderMode,
+		allSchemas:             opts.AllSchemas,
+		excludeSchemas:         opts.ExcludeSchemas,
+		compareClusterObjects:  opts.CompareClusterObjects,
+		compareSettings:        opts.CompareSettings,
 	}
 }
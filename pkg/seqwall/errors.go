@@ -8,6 +8,12 @@ func ErrNoMigrationFiles() error { return sentinelError("no migration files foun
 func ErrNoMigrations() error     { return sentinelError("no migrations found") }
 func ErrBaselineNotFound() error { return sentinelError("baseline not found") }
 func ErrSnapshotsDiffer() error  { return sentinelError("schema snapshots differ") }
+func ErrColumnOrderChanged() error {
+	return sentinelError("column order changed")
+}
+func ErrInvalidColumnOrderMode() error {
+	return sentinelError(`column-order must be either "error" or "warning"`)
+}
 func ErrPostgresURLRequired() error {
 	return sentinelError("postgres URL or DATABASE_URL env is required")
 }
@@ -10,9 +10,21 @@ import (
 	"runtime/debug"
 	"strings"
 
+	"github.com/lib/pq"
 	"github.com/realkarych/seqwall/pkg/driver"
 )
 
+// server_version_num thresholds for catalog columns that differ between releases.
+const (
+	pg14VersionNum = 140000
+	pg15VersionNum = 150000
+	pg16VersionNum = 160000
+	pg17VersionNum = 170000
+)
+
+// firstNormalObjectID is FirstNormalObjectId: objects with a lower oid are created by initdb.
+const firstNormalObjectID = 16384
+
 func (s *StaircaseWorker) Run() error {
 	client, err := driver.NewPostgresClient(s.postgresURL)
 	if err != nil {
@@ -20,6 +32,10 @@ func (s *StaircaseWorker) Run() error {
 	}
 	s.dbClient = client
 	defer s.dbClient.Close()
+	s.serverVersion, err = client.ServerVersionNum()
+	if err != nil {
+		return fmt.Errorf("detect server version: %w", err)
+	}
 	migrations, err := loadMigrations(s.migrationsPath, s.migrationsExtension)
 	if err != nil {
 		return fmt.Errorf("load migrations: %w", err)
@@ -80,6 +96,14 @@ func (s *StaircaseWorker) compareAndSnapshot(exp *driver.SchemaSnapshot, ctx str
 	if err != nil {
 		return fmt.Errorf("%s: %w", ctx, err)
 	}
+	if changes := columnOrderChanges(exp, snap); len(changes) > 0 {
+		report := strings.Join(changes, "\n")
+		if s.columnOrderMode != ColumnOrderWarning {
+			return fmt.Errorf("%s: %w:\n%s", ctx, ErrColumnOrderChanged(), report)
+		}
+		log.Printf("⚠️ %s: %v:\n%s", ctx, ErrColumnOrderChanged(), report)
+		exp, snap = withoutColumnOrder(exp), withoutColumnOrder(snap)
+	}
 	if err := compareSchemas(exp, snap); err != nil {
 		return fmt.Errorf("%s: %w", ctx, err)
 	}
@@ -200,23 +224,53 @@ func (s *StaircaseWorker) calculateStairDepth(migrations []string) int {
 
 func (s *StaircaseWorker) makeSchemaSnapshot() (*driver.SchemaSnapshot, error) {
 	snap := &driver.SchemaSnapshot{
-		Tables:      make(map[string]driver.TableDefinition),
-		Views:       make(map[string]driver.ViewDefinition),
-		MatViews:    make(map[string]driver.MatViewDefinition),
-		Indexes:     make(map[string]driver.IndexDefinition),
-		Constraints: make(map[string]driver.ConstraintDefinition),
-		EnumTypes:   make(map[string]driver.EnumDefinition),
-		ForeignKeys: make(map[string]driver.ForeignKeyDefinition),
+		Schemas:             make(map[string]driver.SchemaDefinition),
+		Tables:              make(map[string]driver.TableDefinition),
+		Views:               make(map[string]driver.ViewDefinition),
+		MatViews:            make(map[string]driver.MatViewDefinition),
+		Indexes:             make(map[string]driver.IndexDefinition),
+		Constraints:         make(map[string]driver.ConstraintDefinition),
+		EnumTypes:           make(map[string]driver.EnumDefinition),
+		DomainTypes:         make(map[string]driver.DomainDefinition),
+		CompositeTypes:      make(map[string]driver.CompositeTypeDefinition),
+		RangeTypes:          make(map[string]driver.RangeTypeDefinition),
+		ForeignKeys:         make(map[string]driver.ForeignKeyDefinition),
+		Policies:            make(map[string]driver.PolicyDefinition),
+		Extensions:          make(map[string]driver.ExtensionDefinition),
+		EventTriggers:       make(map[string]driver.EventTriggerDefinition),
+		Rules:               make(map[string]driver.RuleDefinition),
+		Publications:        make(map[string]driver.PublicationDefinition),
+		Statistics:          make(map[string]driver.StatisticsDefinition),
+		Collations:          make(map[string]driver.CollationDefinition),
+		TSConfigs:           make(map[string]driver.TextSearchConfigDefinition),
+		TSDicts:             make(map[string]driver.TextSearchDictDefinition),
+		ForeignDataWrappers: make(map[string]driver.ForeignDataWrapperDefinition),
+		ForeignServers:      make(map[string]driver.ForeignServerDefinition),
+		UserMappings:        make(map[string]driver.UserMappingDefinition),
+		ForeignTables:       make(map[string]driver.ForeignTableDefinition),
+		Roles:               make(map[string]driver.RoleDefinition),
+		RoleMemberships:     make(map[string]driver.RoleMembershipDefinition),
+		Aggregates:          make(map[string]driver.AggregateDefinition),
+		Operators:           make(map[string]driver.OperatorDefinition),
+		Casts:               make(map[string]driver.CastDefinition),
+		OperatorFamilies:    make(map[string]driver.OperatorFamilyDefinition),
+		OperatorClasses:     make(map[string]driver.OperatorClassDefinition),
+		Tablespaces:         make(map[string]driver.TablespaceDefinition),
+		Settings:            make(map[string]driver.SettingDefinition),
 	}
 	type scanFn struct {
 		fn   func(*driver.SchemaSnapshot) error `json:"-"`
 		name string
 	}
 	scanners := []scanFn{
+		{s.scanSchemas, "schemas"},
 		{s.scanTables, "tables"},
 		{s.scanColumns, "columns"},
 		{s.scanConstraints, "constraints"},
 		{s.scanEnums, "enums"},
+		{s.scanDomains, "domains"},
+		{s.scanCompositeTypes, "composite types"},
+		{s.scanRangeTypes, "range types"},
 		{s.scanFks, "foreign keys"},
 		{s.scanFunctions, "functions"},
 		{s.scanIndexes, "indexes"},
@@ -225,6 +279,36 @@ func (s *StaircaseWorker) makeSchemaSnapshot() (*driver.SchemaSnapshot, error) {
 		{s.scanViews, "views"},
 		{s.scanMatViews, "matviews"},
 		{s.scanPrivileges, "privileges"},
+		{s.scanDefaultPrivileges, "default privileges"},
+		{s.scanPolicies, "policies"},
+		{s.scanExtensions, "extensions"},
+		{s.scanEventTriggers, "event triggers"},
+		{s.scanRules, "rules"},
+		{s.scanPublications, "publications"},
+		{s.scanPublicationSchemas, "publication schemas"},
+		{s.scanStatistics, "extended statistics"},
+		{s.scanCollations, "collations"},
+		{s.scanTextSearchConfigs, "text search configurations"},
+		{s.scanTextSearchDicts, "text search dictionaries"},
+		{s.scanForeignDataWrappers, "foreign data wrappers"},
+		{s.scanForeignServers, "foreign servers"},
+		{s.scanUserMappings, "user mappings"},
+		{s.scanForeignTables, "foreign tables"},
+		{s.scanAggregates, "aggregates"},
+		{s.scanOperators, "operators"},
+		{s.scanCasts, "casts"},
+		{s.scanOperatorFamilies, "operator families"},
+		{s.scanOperatorClasses, "operator classes"},
+	}
+	if s.compareClusterObjects {
+		scanners = append(scanners,
+			scanFn{s.scanRoles, "roles"},
+			scanFn{s.scanRoleMemberships, "role memberships"},
+			scanFn{s.scanTablespaces, "tablespaces"},
+		)
+	}
+	if s.compareSettings {
+		scanners = append(scanners, scanFn{s.scanSettings, "settings"})
 	}
 	for _, sc := range scanners {
 		if err := sc.fn(snap); err != nil {
@@ -234,15 +318,84 @@ func (s *StaircaseWorker) makeSchemaSnapshot() (*driver.SchemaSnapshot, error) {
 	return snap, nil
 }
 
+func (s *StaircaseWorker) scanSchemas(snapshot *driver.SchemaSnapshot) error {
+	schemasQuery := fmt.Sprintf(
+		`
+            SELECT
+                n.nspname,
+                pg_get_userbyid(n.nspowner) AS owner,
+                COALESCE(n.nspacl, acldefault('n', n.nspowner))::text[] AS acl,
+                obj_description(n.oid, 'pg_namespace') AS comment
+            FROM pg_catalog.pg_namespace n
+            WHERE %s
+            ORDER BY n.nspname;
+        `,
+		s.buildUserSchemaCond("n.nspname"),
+	)
+	rows, err := s.dbClient.Execute(schemasQuery)
+	if err != nil {
+		return fmt.Errorf("query schemas: %w", err)
+	}
+	defer rows.Rows.Close()
+	for rows.Rows.Next() {
+		var (
+			schemaName, owner string
+			acl               []string
+			comment           sql.NullString
+		)
+		if err := rows.Rows.Scan(&schemaName, &owner, pq.Array(&acl), &comment); err != nil {
+			return fmt.Errorf("scan schema row: %w", err)
+		}
+		snapshot.Schemas[schemaName] = driver.SchemaDefinition{Owner: owner, ACL: acl, Comment: comment}
+	}
+	if err := rows.Rows.Err(); err != nil {
+		return fmt.Errorf("iterate schema rows: %w", err)
+	}
+	return nil
+}
+
 func (s *StaircaseWorker) scanTables(snapshot *driver.SchemaSnapshot) error {
 	tablesQuery := fmt.Sprintf(
 		`
-            SELECT tablename
-            FROM pg_catalog.pg_tables
-            WHERE %s
-            ORDER BY tablename;
+            SELECT
+                n.nspname,
+                c.relname,
+                c.relrowsecurity,
+                c.relforcerowsecurity,
+                obj_description(c.oid, 'pg_class') AS comment,
+                CASE c.relpersistence
+                    WHEN 'p' THEN 'permanent'
+                    WHEN 'u' THEN 'unlogged'
+                    WHEN 't' THEN 'temporary'
+                END AS persistence,
+                c.reloptions,
+                ts.spcname,
+                CASE c.relreplident
+                    WHEN 'd' THEN 'default'
+                    WHEN 'n' THEN 'nothing'
+                    WHEN 'f' THEN 'full'
+                    WHEN 'i' THEN 'index'
+                END AS replica_identity,
+                pg_get_userbyid(c.relowner) AS owner,
+                CASE WHEN c.relkind = 'p' THEN pg_get_partkeydef(c.oid) END AS partition_key,
+                c.relispartition,
+                pg_get_expr(c.relpartbound, c.oid) AS partition_bound,
+                ARRAY(
+                    SELECT concat_ws('.', pn.nspname, pc.relname)
+                    FROM pg_catalog.pg_inherits i
+                    JOIN pg_catalog.pg_class pc ON pc.oid = i.inhparent
+                    JOIN pg_catalog.pg_namespace pn ON pn.oid = pc.relnamespace
+                    WHERE i.inhrelid = c.oid
+                    ORDER BY i.inhseqno
+                ) AS parents
+            FROM pg_catalog.pg_class c
+            JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+            LEFT JOIN pg_catalog.pg_tablespace ts ON ts.oid = c.reltablespace
+            WHERE c.relkind IN ('r', 'p', 'f')
+              AND %s
+            ORDER BY n.nspname, c.relname;
         `,
-		s.buildSchemaCond("schemaname"),
+		s.buildSchemaCond("n.nspname"),
 	)
 	rows, err := s.dbClient.Execute(tablesQuery)
 	if err != nil {
@@ -251,13 +404,49 @@ func (s *StaircaseWorker) scanTables(snapshot *driver.SchemaSnapshot) error {
 	defer rows.Rows.Close()
 
 	for rows.Rows.Next() {
-		var tableName string
-		if err := rows.Rows.Scan(&tableName); err != nil {
+		var (
+			schemaName, tableName         string
+			rowSecurity, forceRowSecurity bool
+			comment, tablespace           sql.NullString
+			persistence, replicaIdentity  string
+			owner                         string
+			options, parents              []string
+			partitionKey, partitionBound  sql.NullString
+			isPartition                   bool
+		)
+		if err := rows.Rows.Scan(
+			&schemaName,
+			&tableName,
+			&rowSecurity,
+			&forceRowSecurity,
+			&comment,
+			&persistence,
+			pq.Array(&options),
+			&tablespace,
+			&replicaIdentity,
+			&owner,
+			&partitionKey,
+			&isPartition,
+			&partitionBound,
+			pq.Array(&parents),
+		); err != nil {
 			return fmt.Errorf("scan table row: %w", err)
 		}
-		if _, ok := snapshot.Tables[tableName]; !ok {
-			snapshot.Tables[tableName] = driver.TableDefinition{}
-		}
+		key := qualifiedName(schemaName, tableName)
+		td := snapshot.Tables[key]
+		td.RowSecurity = rowSecurity
+		td.ForceRowSecurity = forceRowSecurity
+		td.Comment = comment
+		td.Persistence = persistence
+		td.Options = options
+		td.Tablespace = tablespace
+		td.ReplicaIdentity = replicaIdentity
+		td.Owner = owner
+		td.PartitionKey = partitionKey
+		td.IsPartition = isPartition
+		td.PartitionBound = partitionBound
+		td.Parents = parents
+		snapshot.Tables[key] = td
 	}
 	return rows.Rows.Err()
 }
@@ -270,13 +459,13 @@ func (s *StaircaseWorker) scanColumns(snapshot *driver.SchemaSnapshot) error {
 	}
 	defer rows.Rows.Close()
 	for rows.Rows.Next() {
-		colDef, tableName, err := scanColumnRow(rows)
+		colDef, tableKey, err := scanColumnRow(rows)
 		if err != nil {
 			return err
 		}
-		td := snapshot.Tables[tableName]
+		td := snapshot.Tables[tableKey]
 		td.Columns = append(td.Columns, colDef)
-		snapshot.Tables[tableName] = td
+		snapshot.Tables[tableKey] = td
 	}
 
 	if err := rows.Rows.Err(); err != nil {
@@ -286,8 +475,14 @@ func (s *StaircaseWorker) scanColumns(snapshot *driver.SchemaSnapshot) error {
 }
 
 func (s *StaircaseWorker) buildColumnsQuery() string {
+	// attcompression appeared in PostgreSQL 14.
+	compression := "NULL::text"
+	if s.serverVersion >= pg14VersionNum {
+		compression = "NULLIF(a.attcompression::text, '')"
+	}
 	return fmt.Sprintf(`
         SELECT
+            c.table_schema,
             c.table_name,
             c.column_name,
             c.data_type,
@@ -305,29 +500,56 @@ func (s *StaircaseWorker) buildColumnsQuery() string {
             c.column_default,
             c.character_maximum_length,
             c.numeric_precision,
-            c.numeric_scale
+            c.numeric_scale,
+            col_description(cl.oid, a.attnum) AS comment,
+            CASE a.attstorage
+                WHEN 'p' THEN 'plain'
+                WHEN 'e' THEN 'external'
+                WHEN 'm' THEN 'main'
+                WHEN 'x' THEN 'extended'
+            END AS storage,
+            NULLIF(a.attstattarget, -1) AS statistics_target,
+            %s AS compression,
+            row_number() OVER (
+                PARTITION BY c.table_schema, c.table_name
+                ORDER BY c.ordinal_position
+            ) AS position
         FROM information_schema.columns c
+        JOIN pg_catalog.pg_namespace tn
+            ON tn.nspname = c.udt_schema
         JOIN pg_catalog.pg_type t
-            ON c.udt_name = t.typname
+            ON t.typname = c.udt_name
+            AND t.typnamespace = tn.oid
+        JOIN pg_catalog.pg_namespace cn
+            ON cn.nspname = c.table_schema
+        JOIN pg_catalog.pg_class cl
+            ON cl.relname = c.table_name
+            AND cl.relnamespace = cn.oid
         JOIN pg_catalog.pg_attribute a
-            ON a.attrelid = (c.table_schema||'.'||c.table_name)::regclass
+            ON a.attrelid = cl.oid
             AND a.attname = c.column_name
         WHERE %s
-        ORDER BY c.table_name, c.ordinal_position;
-    `, s.buildSchemaCond("c.table_schema"))
+        ORDER BY c.table_schema, c.table_name, c.ordinal_position;
+    `, compression, s.buildSchemaCond("c.table_schema"))
 }
 
 func scanColumnRow(rows *driver.QueryResult) (driver.ColumnDefinition, string, error) {
 	var (
-		table, name, dtype, udt       string
+		schema, table, name, dtype    string
+		udt                           string
 		typtype, typcategory          string
 		typeOID                       int
 		dtp                           sql.NullInt64
 		nullable, identity, generated string
 		genExpr, def, coll, idGen     sql.NullString
 		charLen, numPrec, numScale    sql.NullInt64
+		storage                       string
+		comment, compression          sql.NullString
+		statisticsTarget              sql.NullInt64
+		position                      int
 	)
 	if err := rows.Rows.Scan(
+		&schema,
 		&table,
 		&name,
 		&dtype,
@@ -346,6 +568,11 @@ func scanColumnRow(rows *driver.QueryResult) (driver.ColumnDefinition, string, e
 		&charLen,
 		&numPrec,
 		&numScale,
+		&comment,
+		&storage,
+		&statisticsTarget,
+		&compression,
+		&position,
 	); err != nil {
 		return driver.ColumnDefinition{}, "", fmt.Errorf("scan column row: %w", err)
 	}
@@ -364,25 +591,35 @@ func scanColumnRow(rows *driver.QueryResult) (driver.ColumnDefinition, string, e
 		IsGenerated:            generated,
 		GenerationExpression:   genExpr,
 		CollationName:          coll,
+		Comment:                comment,
+		Storage:                storage,
+		StatisticsTarget:       statisticsTarget,
+		Compression:            compression,
+		Position:               position,
 		TypeMeta: driver.TypeMeta{
 			Typtype:     typtype,
 			Typcategory: typcategory,
 			TypeOID:     typeOID,
 		},
 	}
-	return col, table, nil
+	return col, qualifiedName(schema, table), nil
 }
 
 func (s *StaircaseWorker) scanViews(snapshot *driver.SchemaSnapshot) error {
 	viewsQuery := fmt.Sprintf(
 		`
             SELECT
-                viewname AS table_name,
-                pg_get_viewdef(viewname::regclass, true) AS definition
-            FROM pg_views
-            WHERE %s;
+                n.nspname AS table_schema,
+                c.relname AS table_name,
+                pg_get_viewdef(c.oid, true) AS definition,
+                obj_description(c.oid, 'pg_class') AS comment,
+                pg_get_userbyid(c.relowner) AS owner
+            FROM pg_catalog.pg_class c
+            JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+            WHERE c.relkind = 'v'
+              AND %s;
         `,
-		s.buildSchemaCond("schemaname"),
+		s.buildSchemaCond("n.nspname"),
 	)
 	viewRows, err := s.dbClient.Execute(viewsQuery)
 	if err != nil {
@@ -390,14 +627,25 @@ func (s *StaircaseWorker) scanViews(snapshot *driver.SchemaSnapshot) error {
 	}
 	defer viewRows.Rows.Close()
 	for viewRows.Rows.Next() {
-		var viewName, viewDefinition string
+		var (
+			schemaName, viewName, viewDefinition string
+			owner                                string
+			comment                              sql.NullString
+		)
 		if err := viewRows.Rows.Scan(
+			&schemaName,
 			&viewName,
 			&viewDefinition,
+			&comment,
+			&owner,
 		); err != nil {
 			return fmt.Errorf("scan view row: %w", err)
 		}
-		snapshot.Views[viewName] = driver.ViewDefinition{Definition: viewDefinition}
+		snapshot.Views[qualifiedName(schemaName, viewName)] = driver.ViewDefinition{
+			Definition: viewDefinition,
+			Comment:    comment,
+			Owner:      owner,
+		}
 	}
 	if err := viewRows.Rows.Err(); err != nil {
 		return fmt.Errorf("iterate view rows: %w", err)
@@ -408,12 +656,24 @@ func (s *StaircaseWorker) scanViews(snapshot *driver.SchemaSnapshot) error {
 func (s *StaircaseWorker) scanIndexes(snapshot *driver.SchemaSnapshot) error {
 	indexesQuery := fmt.Sprintf(
 		`
-            SELECT indexname, indexdef
-            FROM pg_indexes
-            WHERE %s
-            ORDER BY indexname;
+            SELECT
+                n.nspname,
+                ic.relname AS index_name,
+                tc.relname AS table_name,
+                pg_get_indexdef(i.indexrelid) AS index_def,
+                i.indisvalid,
+                i.indisclustered,
+                i.indisreplident,
+                obj_description(i.indexrelid, 'pg_class') AS comment
+            FROM pg_catalog.pg_index i
+            JOIN pg_catalog.pg_class ic ON ic.oid = i.indexrelid
+            JOIN pg_catalog.pg_class tc ON tc.oid = i.indrelid
+            JOIN pg_catalog.pg_namespace n ON n.oid = ic.relnamespace
+            WHERE tc.relkind IN ('r', 'm', 'p')
+              AND %s
+            ORDER BY n.nspname, ic.relname;
         `,
-		s.buildSchemaCond("schemaname"),
+		s.buildSchemaCond("n.nspname"),
 	)
 	indexRows, err := s.dbClient.Execute(indexesQuery)
 	if err != nil {
@@ -421,14 +681,32 @@ func (s *StaircaseWorker) scanIndexes(snapshot *driver.SchemaSnapshot) error {
 	}
 	defer indexRows.Rows.Close()
 	for indexRows.Rows.Next() {
-		var indexName, indexDef string
+		var (
+			schemaName, indexName, tableName  string
+			indexDef                          string
+			isValid, isClustered, isReplIdent bool
+			comment                           sql.NullString
+		)
 		if err := indexRows.Rows.Scan(
+			&schemaName,
 			&indexName,
+			&tableName,
 			&indexDef,
+			&isValid,
+			&isClustered,
+			&isReplIdent,
+			&comment,
 		); err != nil {
 			return fmt.Errorf("scan index row: %w", err)
 		}
-		snapshot.Indexes[indexName] = driver.IndexDefinition{IndexDef: indexDef}
+		snapshot.Indexes[qualifiedName(schemaName, indexName)] = driver.IndexDefinition{
+			TableName:         tableName,
+			IndexDef:          indexDef,
+			IsValid:           isValid,
+			IsClustered:       isClustered,
+			IsReplicaIdentity: isReplIdent,
+			Comment:           comment,
+		}
 	}
 	if err := indexRows.Rows.Err(); err != nil {
 		return fmt.Errorf("iterate index rows: %w", err)
@@ -440,16 +718,31 @@ func (s *StaircaseWorker) scanConstraints(snapshot *driver.SchemaSnapshot) error
 	constraintsQuery := fmt.Sprintf(
 		`
             SELECT
-                tc.constraint_name,
-                tc.table_name,
-                tc.constraint_type,
-                cc.check_clause
-            FROM information_schema.table_constraints tc
-            LEFT JOIN information_schema.check_constraints cc
-                   ON tc.constraint_name = cc.constraint_name
-            WHERE %s;
+                con.conname,
+                n.nspname,
+                c.relname,
+                CASE con.contype
+                    WHEN 'c' THEN 'CHECK'
+                    WHEN 'f' THEN 'FOREIGN KEY'
+                    WHEN 'n' THEN 'NOT NULL'
+                    WHEN 'p' THEN 'PRIMARY KEY'
+                    WHEN 'u' THEN 'UNIQUE'
+                    WHEN 't' THEN 'TRIGGER'
+                    WHEN 'x' THEN 'EXCLUDE'
+                    ELSE con.contype::text
+                END AS constraint_type,
+                pg_get_constraintdef(con.oid, true) AS definition,
+                con.condeferrable,
+                con.condeferred,
+                con.convalidated,
+                obj_description(con.oid, 'pg_constraint') AS comment
+            FROM pg_catalog.pg_constraint con
+            JOIN pg_catalog.pg_class c ON c.oid = con.conrelid
+            JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+            WHERE %s
+            ORDER BY n.nspname, c.relname, con.conname;
         `,
-		s.buildSchemaCond("tc.table_schema"),
+		s.buildSchemaCond("n.nspname"),
 	)
 	constrRows, err := s.dbClient.Execute(constraintsQuery)
 	if err != nil {
@@ -458,21 +751,34 @@ func (s *StaircaseWorker) scanConstraints(snapshot *driver.SchemaSnapshot) error
 	defer constrRows.Rows.Close()
 	for constrRows.Rows.Next() {
 		var (
-			constraintName, tableName, constraintType string
-			checkClause                               sql.NullString
+			constraintName, schemaName, tableName string
+			constraintType                        string
+			definition, comment                   sql.NullString
+			deferrable, initiallyDeferred         bool
+			validated                             bool
 		)
 		if err := constrRows.Rows.Scan(
 			&constraintName,
+			&schemaName,
 			&tableName,
 			&constraintType,
-			&checkClause,
+			&definition,
+			&deferrable,
+			&initiallyDeferred,
+			&validated,
+			&comment,
 		); err != nil {
 			return fmt.Errorf("scan constraint row: %w", err)
 		}
-		snapshot.Constraints[constraintName] = driver.ConstraintDefinition{
-			TableName:      tableName,
-			ConstraintType: constraintType,
-			Definition:     checkClause,
+		snapshot.Constraints[qualifiedName(schemaName, tableName, constraintName)] = driver.ConstraintDefinition{
+			TableSchema:       schemaName,
+			TableName:         tableName,
+			ConstraintType:    constraintType,
+			Definition:        definition,
+			Deferrable:        deferrable,
+			InitiallyDeferred: initiallyDeferred,
+			Validated:         validated,
+			Comment:           comment,
 		}
 	}
 	if err := constrRows.Rows.Err(); err != nil {
@@ -485,13 +791,16 @@ func (s *StaircaseWorker) scanEnums(snapshot *driver.SchemaSnapshot) error {
 	enumQuery := fmt.Sprintf(
 		`
             SELECT
+                n.nspname,
                 t.typname,
-                e.enumlabel
+                e.enumlabel,
+                obj_description(t.oid, 'pg_type') AS comment,
+                pg_get_userbyid(t.typowner) AS owner
             FROM pg_type t
             JOIN pg_enum e ON t.oid = e.enumtypid
             JOIN pg_namespace n ON n.oid = t.typnamespace
             WHERE %s
-            ORDER BY t.typname, e.enumsortorder;
+            ORDER BY n.nspname, t.typname, e.enumsortorder;
         `,
 		s.buildSchemaCond("n.nspname"),
 	)
@@ -501,16 +810,23 @@ func (s *StaircaseWorker) scanEnums(snapshot *driver.SchemaSnapshot) error {
 	}
 	defer enumRows.Rows.Close()
 	for enumRows.Rows.Next() {
-		var typeName, enumLabel string
+		var schemaName, typeName, enumLabel, owner string
+		var comment sql.NullString
 		if err := enumRows.Rows.Scan(
+			&schemaName,
 			&typeName,
 			&enumLabel,
+			&comment,
+			&owner,
 		); err != nil {
 			return fmt.Errorf("scan enum row: %w", err)
 		}
-		def := snapshot.EnumTypes[typeName]
+		key := qualifiedName(schemaName, typeName)
+		def := snapshot.EnumTypes[key]
 		def.Labels = append(def.Labels, enumLabel)
-		snapshot.EnumTypes[typeName] = def
+		def.Comment = comment
+		def.Owner = owner
+		snapshot.EnumTypes[key] = def
 	}
 	if err := enumRows.Rows.Err(); err != nil {
 		return fmt.Errorf("iterate enum rows: %w", err)
@@ -518,25 +834,261 @@ func (s *StaircaseWorker) scanEnums(snapshot *driver.SchemaSnapshot) error {
 	return nil
 }
 
+func (s *StaircaseWorker) scanDomains(snapshot *driver.SchemaSnapshot) error {
+	domainQuery := fmt.Sprintf(
+		`
+            SELECT
+                n.nspname,
+                t.typname,
+                format_type(t.typbasetype, t.typtypmod) AS base_type,
+                t.typnotnull,
+                t.typdefault,
+                coll.collname,
+                con.conname,
+                pg_get_constraintdef(con.oid, true) AS constraint_def,
+                obj_description(t.oid, 'pg_type') AS comment,
+                pg_get_userbyid(t.typowner) AS owner
+            FROM pg_catalog.pg_type t
+            JOIN pg_catalog.pg_namespace n ON n.oid = t.typnamespace
+            LEFT JOIN pg_catalog.pg_collation coll ON coll.oid = t.typcollation
+            LEFT JOIN pg_catalog.pg_constraint con ON con.contypid = t.oid
+            WHERE t.typtype = 'd'
+              AND %s
+            ORDER BY n.nspname, t.typname, con.conname;
+        `,
+		s.buildSchemaCond("n.nspname"),
+	)
+	rows, err := s.dbClient.Execute(domainQuery)
+	if err != nil {
+		return fmt.Errorf("query domains: %w", err)
+	}
+	defer rows.Rows.Close()
+	for rows.Rows.Next() {
+		var (
+			schemaName, typeName, baseType string
+			owner                          string
+			notNull                        bool
+			def, collation                 sql.NullString
+			conName, conDef, comment       sql.NullString
+		)
+		if err := rows.Rows.Scan(
+			&schemaName,
+			&typeName,
+			&baseType,
+			&notNull,
+			&def,
+			&collation,
+			&conName,
+			&conDef,
+			&comment,
+			&owner,
+		); err != nil {
+			return fmt.Errorf("scan domain row: %w", err)
+		}
+		key := qualifiedName(schemaName, typeName)
+		dom, ok := snapshot.DomainTypes[key]
+		if !ok {
+			dom = driver.DomainDefinition{
+				BaseType:    baseType,
+				NotNull:     notNull,
+				Default:     def,
+				Collation:   collation,
+				Constraints: make(map[string]string),
+				Comment:     comment,
+				Owner:       owner,
+			}
+		}
+		if conName.Valid {
+			dom.Constraints[conName.String] = conDef.String
+		}
+		snapshot.DomainTypes[key] = dom
+	}
+	if err := rows.Rows.Err(); err != nil {
+		return fmt.Errorf("iterate domain rows: %w", err)
+	}
+	return nil
+}
+
+func (s *StaircaseWorker) scanCompositeTypes(snapshot *driver.SchemaSnapshot) error {
+	compositeQuery := fmt.Sprintf(
+		`
+            SELECT
+                n.nspname,
+                t.typname,
+                a.attname,
+                format_type(a.atttypid, a.atttypmod) AS data_type,
+                coll.collname,
+                obj_description(t.oid, 'pg_type') AS comment,
+                pg_get_userbyid(t.typowner) AS owner
+            FROM pg_catalog.pg_type t
+            JOIN pg_catalog.pg_namespace n ON n.oid = t.typnamespace
+            JOIN pg_catalog.pg_class c ON c.oid = t.typrelid
+            JOIN pg_catalog.pg_attribute a ON a.attrelid = c.oid
+            LEFT JOIN pg_catalog.pg_collation coll ON coll.oid = a.attcollation
+            WHERE t.typtype = 'c'
+              AND c.relkind = 'c'
+              AND a.attnum > 0
+              AND NOT a.attisdropped
+              AND %s
+            ORDER BY n.nspname, t.typname, a.attnum;
+        `,
+		s.buildSchemaCond("n.nspname"),
+	)
+	rows, err := s.dbClient.Execute(compositeQuery)
+	if err != nil {
+		return fmt.Errorf("query composite types: %w", err)
+	}
+	defer rows.Rows.Close()
+	for rows.Rows.Next() {
+		var (
+			schemaName, typeName, attName, dataType string
+			owner                                   string
+			collation, comment                      sql.NullString
+		)
+		if err := rows.Rows.Scan(
+			&schemaName,
+			&typeName,
+			&attName,
+			&dataType,
+			&collation,
+			&comment,
+			&owner,
+		); err != nil {
+			return fmt.Errorf("scan composite type row: %w", err)
+		}
+		key := qualifiedName(schemaName, typeName)
+		def := snapshot.CompositeTypes[key]
+		def.Attributes = append(def.Attributes, driver.CompositeAttribute{
+			Name:      attName,
+			DataType:  dataType,
+			Collation: collation,
+		})
+		def.Comment = comment
+		def.Owner = owner
+		snapshot.CompositeTypes[key] = def
+	}
+	if err := rows.Rows.Err(); err != nil {
+		return fmt.Errorf("iterate composite type rows: %w", err)
+	}
+	return nil
+}
+
+func (s *StaircaseWorker) scanRangeTypes(snapshot *driver.SchemaSnapshot) error {
+	rangeQuery := fmt.Sprintf(
+		`
+            SELECT
+                n.nspname,
+                t.typname,
+                format_type(r.rngsubtype, NULL) AS subtype,
+                coll.collname,
+                opc.opcname,
+                CASE WHEN r.rngcanonical::oid = 0 THEN NULL ELSE r.rngcanonical::text END AS canonical,
+                CASE WHEN r.rngsubdiff::oid = 0 THEN NULL ELSE r.rngsubdiff::text END AS subtype_diff,
+                obj_description(t.oid, 'pg_type') AS comment,
+                pg_get_userbyid(t.typowner) AS owner
+            FROM pg_catalog.pg_range r
+            JOIN pg_catalog.pg_type t ON t.oid = r.rngtypid
+            JOIN pg_catalog.pg_namespace n ON n.oid = t.typnamespace
+            JOIN pg_catalog.pg_opclass opc ON opc.oid = r.rngsubopc
+            LEFT JOIN pg_catalog.pg_collation coll ON coll.oid = r.rngcollation
+            WHERE %s
+            ORDER BY n.nspname, t.typname;
+        `,
+		s.buildSchemaCond("n.nspname"),
+	)
+	rows, err := s.dbClient.Execute(rangeQuery)
+	if err != nil {
+		return fmt.Errorf("query range types: %w", err)
+	}
+	defer rows.Rows.Close()
+	for rows.Rows.Next() {
+		var (
+			schemaName, typeName, subtype, opClass string
+			owner                                  string
+			collation, canonical, subtypeDiff      sql.NullString
+			comment                                sql.NullString
+		)
+		if err := rows.Rows.Scan(
+			&schemaName,
+			&typeName,
+			&subtype,
+			&collation,
+			&opClass,
+			&canonical,
+			&subtypeDiff,
+			&comment,
+			&owner,
+		); err != nil {
+			return fmt.Errorf("scan range type row: %w", err)
+		}
+		snapshot.RangeTypes[qualifiedName(schemaName, typeName)] = driver.RangeTypeDefinition{
+			Subtype:        subtype,
+			Collation:      collation,
+			SubtypeOpClass: opClass,
+			Canonical:      canonical,
+			SubtypeDiff:    subtypeDiff,
+			Comment:        comment,
+			Owner:          owner,
+		}
+	}
+	if err := rows.Rows.Err(); err != nil {
+		return fmt.Errorf("iterate range type rows: %w", err)
+	}
+	return nil
+}
+
 func (s *StaircaseWorker) scanFks(snapshot *driver.SchemaSnapshot) error {
 	foreignKeysQuery := fmt.Sprintf(
 		`
             SELECT
-                tc.constraint_name,
-                tc.table_name,
-                kcu.column_name,
-                ccu.table_name  AS foreign_table_name,
-                ccu.column_name AS foreign_column_name,
-                rc.update_rule,
-                rc.delete_rule
-            FROM information_schema.table_constraints        AS tc
-            JOIN information_schema.key_column_usage         AS kcu ON tc.constraint_name = kcu.constraint_name
-            JOIN information_schema.referential_constraints  AS rc  ON tc.constraint_name = rc.constraint_name
-            JOIN information_schema.constraint_column_usage  AS ccu ON ccu.constraint_name = tc.constraint_name
-            WHERE tc.constraint_type = 'FOREIGN KEY'
-              AND %s;
+                con.conname,
+                n.nspname,
+                c.relname,
+                ARRAY(
+                    SELECT a.attname
+                    FROM unnest(con.conkey) WITH ORDINALITY AS k(attnum, ord)
+                    JOIN pg_catalog.pg_attribute a ON a.attrelid = con.conrelid AND a.attnum = k.attnum
+                    ORDER BY k.ord
+                ) AS columns,
+                fn.nspname AS foreign_table_schema,
+                fc.relname AS foreign_table_name,
+                ARRAY(
+                    SELECT a.attname
+                    FROM unnest(con.confkey) WITH ORDINALITY AS k(attnum, ord)
+                    JOIN pg_catalog.pg_attribute a ON a.attrelid = con.confrelid AND a.attnum = k.attnum
+                    ORDER BY k.ord
+                ) AS foreign_columns,
+                CASE con.confmatchtype
+                    WHEN 'f' THEN 'FULL'
+                    WHEN 'p' THEN 'PARTIAL'
+                    WHEN 's' THEN 'SIMPLE'
+                END AS match_type,
+                CASE con.confupdtype
+                    WHEN 'a' THEN 'NO ACTION'
+                    WHEN 'r' THEN 'RESTRICT'
+                    WHEN 'c' THEN 'CASCADE'
+                    WHEN 'n' THEN 'SET NULL'
+                    WHEN 'd' THEN 'SET DEFAULT'
+                END AS update_rule,
+                CASE con.confdeltype
+                    WHEN 'a' THEN 'NO ACTION'
+                    WHEN 'r' THEN 'RESTRICT'
+                    WHEN 'c' THEN 'CASCADE'
+                    WHEN 'n' THEN 'SET NULL'
+                    WHEN 'd' THEN 'SET DEFAULT'
+                END AS delete_rule,
+                con.condeferrable,
+                con.condeferred
+            FROM pg_catalog.pg_constraint con
+            JOIN pg_catalog.pg_class c ON c.oid = con.conrelid
+            JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+            JOIN pg_catalog.pg_class fc ON fc.oid = con.confrelid
+            JOIN pg_catalog.pg_namespace fn ON fn.oid = fc.relnamespace
+            WHERE con.contype = 'f'
+              AND %s
+            ORDER BY n.nspname, c.relname, con.conname;
         `,
-		s.buildSchemaCond("tc.table_schema"),
+		s.buildSchemaCond("n.nspname"),
 	)
 	rows, err := s.dbClient.Execute(foreignKeysQuery)
 	if err != nil {
@@ -545,29 +1097,41 @@ func (s *StaircaseWorker) scanFks(snapshot *driver.SchemaSnapshot) error {
 	defer rows.Rows.Close()
 	for rows.Rows.Next() {
 		var (
-			constraintName, tableName, columnName string
-			foreignTableName, foreignColumnName   string
-			updateRule, deleteRule                string
+			constraintName, schemaName, tableName string
+			foreignSchemaName, foreignTableName   string
+			matchType, updateRule, deleteRule     string
+			columns, foreignColumns               []string
+			deferrable, initiallyDeferred         bool
 		)
 		if err := rows.Rows.Scan(
 			&constraintName,
+			&schemaName,
 			&tableName,
-			&columnName,
+			pq.Array(&columns),
+			&foreignSchemaName,
 			&foreignTableName,
-			&foreignColumnName,
+			pq.Array(&foreignColumns),
+			&matchType,
 			&updateRule,
 			&deleteRule,
+			&deferrable,
+			&initiallyDeferred,
 		); err != nil {
 			return fmt.Errorf("scan foreign key row: %w", err)
 		}
-		snapshot.ForeignKeys[constraintName] = driver.ForeignKeyDefinition{
-			ConstraintName:    constraintName,
-			TableName:         tableName,
-			ColumnName:        columnName,
-			ForeignTableName:  foreignTableName,
-			ForeignColumnName: foreignColumnName,
-			UpdateRule:        updateRule,
-			DeleteRule:        deleteRule,
+		snapshot.ForeignKeys[qualifiedName(schemaName, tableName, constraintName)] = driver.ForeignKeyDefinition{
+			ConstraintName:     constraintName,
+			TableSchema:        schemaName,
+			TableName:          tableName,
+			Columns:            columns,
+			ForeignTableSchema: foreignSchemaName,
+			ForeignTableName:   foreignTableName,
+			ForeignColumns:     foreignColumns,
+			MatchType:          matchType,
+			UpdateRule:         updateRule,
+			DeleteRule:         deleteRule,
+			Deferrable:         deferrable,
+			InitiallyDeferred:  initiallyDeferred,
 		}
 	}
 	if err := rows.Rows.Err(); err != nil {
@@ -580,16 +1144,25 @@ func (s *StaircaseWorker) scanTriggers(snapshot *driver.SchemaSnapshot) error {
 	triggersQuery := fmt.Sprintf(
 		`
             SELECT
-                trigger_name,
-                event_manipulation,
-                event_object_table,
-                action_timing,
-                action_statement
-            FROM information_schema.triggers
-            WHERE %s
-            ORDER BY trigger_name;
+                t.tgname,
+                n.nspname,
+                c.relname,
+                pg_get_triggerdef(t.oid, true) AS definition,
+                CASE t.tgenabled
+                    WHEN 'O' THEN 'ORIGIN'
+                    WHEN 'D' THEN 'DISABLED'
+                    WHEN 'R' THEN 'REPLICA'
+                    WHEN 'A' THEN 'ALWAYS'
+                END AS enabled,
+                obj_description(t.oid, 'pg_trigger') AS comment
+            FROM pg_catalog.pg_trigger t
+            JOIN pg_catalog.pg_class c ON c.oid = t.tgrelid
+            JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+            WHERE NOT t.tgisinternal
+              AND %s
+            ORDER BY n.nspname, c.relname, t.tgname;
         `,
-		s.buildSchemaCond("trigger_schema"),
+		s.buildSchemaCond("n.nspname"),
 	)
 	rows, err := s.dbClient.Execute(triggersQuery)
 	if err != nil {
@@ -600,25 +1173,24 @@ func (s *StaircaseWorker) scanTriggers(snapshot *driver.SchemaSnapshot) error {
 		snapshot.Triggers = make(map[string]driver.TriggerDefinition)
 	}
 	for rows.Rows.Next() {
-		var (
-			triggerName, eventManipulation, eventObjectTable string
-			actionTiming, actionStatement                    string
-		)
+		var triggerName, schemaName, tableName, definition, enabled string
+		var comment sql.NullString
 		if err := rows.Rows.Scan(
 			&triggerName,
-			&eventManipulation,
-			&eventObjectTable,
-			&actionTiming,
-			&actionStatement,
+			&schemaName,
+			&tableName,
+			&definition,
+			&enabled,
+			&comment,
 		); err != nil {
 			return fmt.Errorf("scan trigger row: %w", err)
 		}
-		snapshot.Triggers[triggerName] = driver.TriggerDefinition{
-			TriggerName:       triggerName,
-			EventManipulation: eventManipulation,
-			EventObjectTable:  eventObjectTable,
-			ActionTiming:      actionTiming,
-			ActionStatement:   actionStatement,
+		snapshot.Triggers[qualifiedName(schemaName, tableName, triggerName)] = driver.TriggerDefinition{
+			TriggerName: triggerName,
+			TableName:   tableName,
+			Definition:  definition,
+			Enabled:     enabled,
+			Comment:     comment,
 		}
 	}
 	if err := rows.Rows.Err(); err != nil {
@@ -630,12 +1202,42 @@ func (s *StaircaseWorker) scanTriggers(snapshot *driver.SchemaSnapshot) error {
 func (s *StaircaseWorker) scanFunctions(snapshot *driver.SchemaSnapshot) error {
 	routinesQuery := fmt.Sprintf(
 		`
-            SELECT routine_name, routine_type, data_type
-            FROM information_schema.routines
-            WHERE %s
-            ORDER BY routine_name;
+            SELECT
+                n.nspname,
+                p.proname,
+                pg_get_function_identity_arguments(p.oid) AS identity_arguments,
+                CASE p.prokind
+                    WHEN 'f' THEN 'FUNCTION'
+                    WHEN 'p' THEN 'PROCEDURE'
+                    WHEN 'w' THEN 'WINDOW'
+                END AS routine_type,
+                pg_get_function_result(p.oid) AS return_type,
+                pg_get_functiondef(p.oid) AS definition,
+                obj_description(p.oid, 'pg_proc') AS comment,
+                pg_get_userbyid(p.proowner) AS owner,
+                CASE p.provolatile
+                    WHEN 'i' THEN 'IMMUTABLE'
+                    WHEN 's' THEN 'STABLE'
+                    WHEN 'v' THEN 'VOLATILE'
+                END AS volatility,
+                p.prosecdef,
+                p.proleakproof,
+                CASE p.proparallel
+                    WHEN 's' THEN 'SAFE'
+                    WHEN 'r' THEN 'RESTRICTED'
+                    WHEN 'u' THEN 'UNSAFE'
+                END AS parallel,
+                p.proconfig,
+                l.lanname,
+                p.procost
+            FROM pg_catalog.pg_proc p
+            JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+            JOIN pg_catalog.pg_language l ON l.oid = p.prolang
+            WHERE p.prokind <> 'a'
+              AND %s
+            ORDER BY n.nspname, p.proname, identity_arguments;
         `,
-		s.buildSchemaCond("specific_schema"),
+		s.buildSchemaCond("n.nspname"),
 	)
 	rows, err := s.dbClient.Execute(routinesQuery)
 	if err != nil {
@@ -647,14 +1249,34 @@ func (s *StaircaseWorker) scanFunctions(snapshot *driver.SchemaSnapshot) error {
 	}
 	for rows.Rows.Next() {
 		var (
-			routineName     string
-			routineTypeNull sql.NullString
-			returnType      string
+			schemaName, routineName string
+			identityArgs, owner     string
+			routineTypeNull         sql.NullString
+			returnType              sql.NullString
+			definition, comment     sql.NullString
+			volatility, parallel    string
+			language                string
+			securityDefiner         bool
+			leakProof               bool
+			config                  []string
+			cost                    float64
 		)
 		if err := rows.Rows.Scan(
+			&schemaName,
 			&routineName,
+			&identityArgs,
 			&routineTypeNull,
 			&returnType,
+			&definition,
+			&comment,
+			&owner,
+			&volatility,
+			&securityDefiner,
+			&leakProof,
+			&parallel,
+			pq.Array(&config),
+			&language,
+			&cost,
 		); err != nil {
 			return fmt.Errorf("scan function row: %w", err)
 		}
@@ -662,10 +1284,22 @@ func (s *StaircaseWorker) scanFunctions(snapshot *driver.SchemaSnapshot) error {
 		if routineTypeNull.Valid {
 			routineType = routineTypeNull.String
 		}
-		snapshot.Functions[routineName] = driver.FunctionDefinition{
-			RoutineName: routineName,
-			RoutineType: routineType,
-			ReturnType:  returnType,
+		signature := qualifiedName(schemaName, fmt.Sprintf("%s(%s)", routineName, identityArgs))
+		snapshot.Functions[signature] = driver.FunctionDefinition{
+			RoutineName:       routineName,
+			RoutineType:       routineType,
+			IdentityArguments: identityArgs,
+			ReturnType:        returnType.String,
+			Definition:        definition,
+			Comment:           comment,
+			Owner:             owner,
+			Volatility:        volatility,
+			SecurityDefiner:   securityDefiner,
+			LeakProof:         leakProof,
+			Parallel:          parallel,
+			Config:            config,
+			Language:          language,
+			Cost:              cost,
 		}
 	}
 	if err := rows.Rows.Err(); err != nil {
@@ -677,12 +1311,31 @@ func (s *StaircaseWorker) scanFunctions(snapshot *driver.SchemaSnapshot) error {
 func (s *StaircaseWorker) scanSeqs(snapshot *driver.SchemaSnapshot) error {
 	seqQuery := fmt.Sprintf(
 		`
-            SELECT sequence_name, data_type, start_value, minimum_value, maximum_value, increment, cycle_option
-            FROM information_schema.sequences
+            SELECT
+                s.sequence_schema, s.sequence_name, s.data_type, s.start_value,
+                s.minimum_value, s.maximum_value, s.increment, s.cycle_option,
+                obj_description(c.oid, 'pg_class') AS comment,
+                pg_get_userbyid(c.relowner) AS owner,
+                (
+                    SELECT concat_ws('.', rn.nspname, rc.relname, a.attname)
+                    FROM pg_catalog.pg_depend d
+                    JOIN pg_catalog.pg_class rc ON rc.oid = d.refobjid
+                    JOIN pg_catalog.pg_namespace rn ON rn.oid = rc.relnamespace
+                    JOIN pg_catalog.pg_attribute a ON a.attrelid = d.refobjid AND a.attnum = d.refobjsubid
+                    WHERE d.classid = 'pg_catalog.pg_class'::regclass
+                      AND d.objid = c.oid
+                      AND d.refclassid = 'pg_catalog.pg_class'::regclass
+                      AND d.refobjsubid > 0
+                      AND d.deptype IN ('a', 'i')
+                    LIMIT 1
+                ) AS owned_by
+            FROM information_schema.sequences s
+            JOIN pg_catalog.pg_class c
+                ON c.oid = format('%%I.%%I', s.sequence_schema, s.sequence_name)::regclass
             WHERE %s
-            ORDER BY sequence_name;
+            ORDER BY s.sequence_schema, s.sequence_name;
         `,
-		s.buildSchemaCond("sequence_schema"),
+		s.buildSchemaCond("s.sequence_schema"),
 	)
 	rows, err := s.dbClient.Execute(seqQuery)
 	if err != nil {
@@ -692,13 +1345,17 @@ func (s *StaircaseWorker) scanSeqs(snapshot *driver.SchemaSnapshot) error {
 	if snapshot.Sequences == nil {
 		snapshot.Sequences = make(map[string]driver.SequenceDefinition)
 	}
+	seqSchemas := make(map[string]string)
 	for rows.Rows.Next() {
 		var (
-			sequenceName, dataType, startValue string
-			minValue, maxValue, increment      string
-			cycleOption                        string
+			schemaName, sequenceName      string
+			dataType, startValue          string
+			minValue, maxValue, increment string
+			cycleOption, owner            string
+			comment, ownedBy              sql.NullString
 		)
 		if err := rows.Rows.Scan(
+			&schemaName,
 			&sequenceName,
 			&dataType,
 			&startValue,
@@ -706,10 +1363,15 @@ func (s *StaircaseWorker) scanSeqs(snapshot *driver.SchemaSnapshot) error {
 			&maxValue,
 			&increment,
 			&cycleOption,
+			&comment,
+			&owner,
+			&ownedBy,
 		); err != nil {
 			return fmt.Errorf("scan sequence row: %w", err)
 		}
-		snapshot.Sequences[sequenceName] = driver.SequenceDefinition{
+		key := qualifiedName(schemaName, sequenceName)
+		seqSchemas[key] = schemaName
+		snapshot.Sequences[key] = driver.SequenceDefinition{
 			SequenceName: sequenceName,
 			DataType:     dataType,
 			StartValue:   startValue,
@@ -717,11 +1379,53 @@ func (s *StaircaseWorker) scanSeqs(snapshot *driver.SchemaSnapshot) error {
 			MaxValue:     maxValue,
 			Increment:    increment,
 			CycleOption:  cycleOption,
+			Comment:      comment,
+			Owner:        owner,
+			OwnedBy:      ownedBy,
 		}
 	}
 	if err := rows.Rows.Err(); err != nil {
 		return fmt.Errorf("iterate sequence rows: %w", err)
 	}
+	if s.compareSequenceState {
+		return s.scanSeqState(snapshot, seqSchemas)
+	}
+	return nil
+}
+
+// scanSeqState reads last_value and is_called from every sequence relation.
+// pg_sequence_last_value is not enough: it hides last_value while is_called is
+// false, so RESTART and setval(..., false) would go unnoticed.
+func (s *StaircaseWorker) scanSeqState(snapshot *driver.SchemaSnapshot, seqSchemas map[string]string) error {
+	for key, schemaName := range seqSchemas {
+		seq := snapshot.Sequences[key]
+		stateQuery := fmt.Sprintf(
+			"SELECT last_value, is_called FROM %s.%s",
+			pq.QuoteIdentifier(schemaName),
+			pq.QuoteIdentifier(seq.SequenceName),
+		)
+		if err := s.scanSeqStateRow(stateQuery, &seq); err != nil {
+			return fmt.Errorf("sequence %s: %w", key, err)
+		}
+		snapshot.Sequences[key] = seq
+	}
+	return nil
+}
+
+func (s *StaircaseWorker) scanSeqStateRow(stateQuery string, seq *driver.SequenceDefinition) error {
+	rows, err := s.dbClient.Execute(stateQuery)
+	if err != nil {
+		return fmt.Errorf("query sequence state: %w", err)
+	}
+	defer rows.Rows.Close()
+	for rows.Rows.Next() {
+		if err := rows.Rows.Scan(&seq.LastValue, &seq.IsCalled); err != nil {
+			return fmt.Errorf("scan sequence state row: %w", err)
+		}
+	}
+	if err := rows.Rows.Err(); err != nil {
+		return fmt.Errorf("iterate sequence state rows: %w", err)
+	}
 	return nil
 }
 
@@ -729,13 +1433,18 @@ func (s *StaircaseWorker) scanMatViews(snapshot *driver.SchemaSnapshot) error {
 	matviewsQuery := fmt.Sprintf(
 		`
             SELECT
-                matviewname AS table_name,
-                pg_get_viewdef(matviewname::regclass, true) AS definition,
-                ispopulated
-            FROM pg_matviews
-            WHERE %s;
+                n.nspname AS table_schema,
+                c.relname AS table_name,
+                pg_get_viewdef(c.oid, true) AS definition,
+                c.relispopulated,
+                obj_description(c.oid, 'pg_class') AS comment,
+                pg_get_userbyid(c.relowner) AS owner
+            FROM pg_catalog.pg_class c
+            JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+            WHERE c.relkind = 'm'
+              AND %s;
         `,
-		s.buildSchemaCond("schemaname"),
+		s.buildSchemaCond("n.nspname"),
 	)
 	rows, err := s.dbClient.Execute(matviewsQuery)
 	if err != nil {
@@ -743,18 +1452,24 @@ func (s *StaircaseWorker) scanMatViews(snapshot *driver.SchemaSnapshot) error {
 	}
 	defer rows.Rows.Close()
 	for rows.Rows.Next() {
-		var matviewName, matviewDefinition string
+		var schemaName, matviewName, matviewDefinition, owner string
 		var isPopulated bool
+		var comment sql.NullString
 		if err := rows.Rows.Scan(
+			&schemaName,
 			&matviewName,
 			&matviewDefinition,
 			&isPopulated,
+			&comment,
+			&owner,
 		); err != nil {
 			return fmt.Errorf("scan matview row: %w", err)
 		}
-		snapshot.MatViews[matviewName] = driver.MatViewDefinition{
+		snapshot.MatViews[qualifiedName(schemaName, matviewName)] = driver.MatViewDefinition{
 			Definition:  matviewDefinition,
 			IsPopulated: isPopulated,
+			Comment:     comment,
+			Owner:       owner,
 		}
 	}
 	if err := rows.Rows.Err(); err != nil {
@@ -763,15 +1478,97 @@ func (s *StaircaseWorker) scanMatViews(snapshot *driver.SchemaSnapshot) error {
 	return nil
 }
 
+// scanPrivileges explodes the catalog ACL columns of every ACL-bearing object.
+// NULL ACLs are replaced with acldefault, so implicit owner/PUBLIC privileges
+// compare equal to the same privileges granted explicitly.
 func (s *StaircaseWorker) scanPrivileges(snapshot *driver.SchemaSnapshot) error {
 	privQuery := fmt.Sprintf(
 		`
-            SELECT grantee, table_name, privilege_type, is_grantable
-		    FROM information_schema.role_table_grants
-		    WHERE %s
-		    ORDER BY grantee, table_name, privilege_type, is_grantable;
+            SELECT
+                o.object_type,
+                o.object_name,
+                pg_get_userbyid(acl.grantor) AS grantor,
+                CASE WHEN acl.grantee = 0 THEN 'PUBLIC' ELSE pg_get_userbyid(acl.grantee) END AS grantee,
+                acl.privilege_type,
+                CASE WHEN acl.is_grantable THEN 'YES' ELSE 'NO' END AS is_grantable
+            FROM (
+                SELECT
+                    CASE WHEN c.relkind = 'S' THEN 'SEQUENCE' ELSE 'TABLE' END AS object_type,
+                    n.nspname || '.' || c.relname AS object_name,
+                    COALESCE(c.relacl, acldefault(CASE WHEN c.relkind = 'S' THEN 's' ELSE 'r' END::"char", c.relowner)) AS acl
+                FROM pg_catalog.pg_class c
+                JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+                WHERE c.relkind IN ('r', 'p', 'v', 'm', 'f', 'S')
+                  AND %[1]s
+                UNION ALL
+                SELECT
+                    'COLUMN',
+                    n.nspname || '.' || c.relname || '.' || a.attname,
+                    a.attacl
+                FROM pg_catalog.pg_attribute a
+                JOIN pg_catalog.pg_class c ON c.oid = a.attrelid
+                JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+                WHERE a.attacl IS NOT NULL
+                  AND a.attnum > 0
+                  AND NOT a.attisdropped
+                  AND %[1]s
+                UNION ALL
+                SELECT
+                    CASE WHEN p.prokind = 'p' THEN 'PROCEDURE' ELSE 'FUNCTION' END,
+                    n.nspname || '.' || p.proname || '(' || pg_get_function_identity_arguments(p.oid) || ')',
+                    COALESCE(p.proacl, acldefault('f', p.proowner))
+                FROM pg_catalog.pg_proc p
+                JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+                WHERE %[1]s
+                UNION ALL
+                SELECT
+                    'TYPE',
+                    n.nspname || '.' || t.typname,
+                    COALESCE(t.typacl, acldefault('T', t.typowner))
+                FROM pg_catalog.pg_type t
+                JOIN pg_catalog.pg_namespace n ON n.oid = t.typnamespace
+                LEFT JOIN pg_catalog.pg_class tc ON tc.oid = t.typrelid
+                WHERE t.typelem = 0
+                  AND (tc.oid IS NULL OR tc.relkind = 'c')
+                  AND %[1]s
+                UNION ALL
+                SELECT
+                    'SCHEMA',
+                    n.nspname,
+                    COALESCE(n.nspacl, acldefault('n', n.nspowner))
+                FROM pg_catalog.pg_namespace n
+                WHERE %[1]s
+                UNION ALL
+                SELECT
+                    'DATABASE',
+                    d.datname,
+                    COALESCE(d.datacl, acldefault('d', d.datdba))
+                FROM pg_catalog.pg_database d
+                WHERE d.datname = current_database()
+                UNION ALL
+                SELECT
+                    'LANGUAGE',
+                    l.lanname,
+                    COALESCE(l.lanacl, acldefault('l', l.lanowner))
+                FROM pg_catalog.pg_language l
+                WHERE l.lanispl
+                UNION ALL
+                SELECT
+                    'FOREIGN DATA WRAPPER',
+                    w.fdwname,
+                    COALESCE(w.fdwacl, acldefault('F', w.fdwowner))
+                FROM pg_catalog.pg_foreign_data_wrapper w
+                UNION ALL
+                SELECT
+                    'FOREIGN SERVER',
+                    fs.srvname,
+                    COALESCE(fs.srvacl, acldefault('S', fs.srvowner))
+                FROM pg_catalog.pg_foreign_server fs
+            ) o
+            CROSS JOIN LATERAL aclexplode(o.acl) acl
+            ORDER BY o.object_type, o.object_name, grantee, acl.privilege_type, grantor, is_grantable;
         `,
-		s.buildSchemaCond("table_schema"),
+		s.buildSchemaCond("n.nspname"),
 	)
 	rows, err := s.dbClient.Execute(privQuery)
 	if err != nil {
@@ -780,13 +1577,15 @@ func (s *StaircaseWorker) scanPrivileges(snapshot *driver.SchemaSnapshot) error
 	defer rows.Rows.Close()
 	var privs []driver.PrivilegeDefinition
 	for rows.Rows.Next() {
-		var grantee, tableName, privilegeType, isGrantable string
-		if err := rows.Rows.Scan(&grantee, &tableName, &privilegeType, &isGrantable); err != nil {
+		var objectType, objectName, grantor, grantee, privilegeType, isGrantable string
+		if err := rows.Rows.Scan(&objectType, &objectName, &grantor, &grantee, &privilegeType, &isGrantable); err != nil {
 			return fmt.Errorf("scan privilege row: %w", err)
 		}
 		privs = append(privs, driver.PrivilegeDefinition{
+			ObjectType:  objectType,
+			ObjectName:  objectName,
+			Grantor:     grantor,
 			Grantee:     grantee,
-			TableName:   tableName,
 			Privilege:   privilegeType,
 			IsGrantable: isGrantable,
 		})
@@ -798,20 +1597,1369 @@ func (s *StaircaseWorker) scanPrivileges(snapshot *driver.SchemaSnapshot) error
 	return nil
 }
 
-// buildSchemaCond("table_schema") -> "table_schema = 'public'".
-// buildSchemaCond("tc.table_schema") -> "tc.table_schema IN ('public','extra')".
-func (s *StaircaseWorker) buildSchemaCond(col string) string {
-	list := s.schemas
-	if len(list) == 0 {
-		list = []string{"public"}
+func (s *StaircaseWorker) scanDefaultPrivileges(snapshot *driver.SchemaSnapshot) error {
+	defaultPrivQuery := fmt.Sprintf(
+		`
+            SELECT
+                pg_get_userbyid(d.defaclrole) AS role_name,
+                COALESCE(n.nspname, '') AS schema_name,
+                CASE d.defaclobjtype
+                    WHEN 'r' THEN 'TABLE'
+                    WHEN 'S' THEN 'SEQUENCE'
+                    WHEN 'f' THEN 'FUNCTION'
+                    WHEN 'T' THEN 'TYPE'
+                    WHEN 'n' THEN 'SCHEMA'
+                    WHEN 'L' THEN 'LARGE OBJECT'
+                    ELSE d.defaclobjtype::text
+                END AS object_type,
+                CASE WHEN acl.grantee = 0 THEN 'PUBLIC' ELSE pg_get_userbyid(acl.grantee) END AS grantee,
+                acl.privilege_type,
+                CASE WHEN acl.is_grantable THEN 'YES' ELSE 'NO' END AS is_grantable
+            FROM pg_catalog.pg_default_acl d
+            LEFT JOIN pg_catalog.pg_namespace n ON n.oid = d.defaclnamespace
+            CROSS JOIN LATERAL aclexplode(d.defaclacl) acl
+            WHERE d.defaclnamespace = 0
+               OR %s
+            ORDER BY role_name, schema_name, object_type, grantee, acl.privilege_type, is_grantable;
+        `,
+		s.buildSchemaCond("n.nspname"),
+	)
+	rows, err := s.dbClient.Execute(defaultPrivQuery)
+	if err != nil {
+		return fmt.Errorf("query default privileges: %w", err)
 	}
-	quote := func(s string) string { return "'" + strings.ReplaceAll(s, "'", "''") + "'" }
-	if len(list) == 1 {
-		return fmt.Sprintf("%s = %s", col, quote(list[0]))
+	defer rows.Rows.Close()
+	var privs []driver.DefaultPrivilegeDefinition
+	for rows.Rows.Next() {
+		var roleName, schemaName, objectType, grantee, privilegeType, isGrantable string
+		if err := rows.Rows.Scan(&roleName, &schemaName, &objectType, &grantee, &privilegeType, &isGrantable); err != nil {
+			return fmt.Errorf("scan default privilege row: %w", err)
+		}
+		privs = append(privs, driver.DefaultPrivilegeDefinition{
+			Role:        roleName,
+			Schema:      schemaName,
+			ObjectType:  objectType,
+			Grantee:     grantee,
+			Privilege:   privilegeType,
+			IsGrantable: isGrantable,
+		})
+	}
+	if err := rows.Rows.Err(); err != nil {
+		return fmt.Errorf("iterate default privilege rows: %w", err)
+	}
+	snapshot.DefaultPrivileges = privs
+	return nil
+}
+
+func (s *StaircaseWorker) scanPolicies(snapshot *driver.SchemaSnapshot) error {
+	policiesQuery := fmt.Sprintf(
+		`
+            SELECT
+                pp.schemaname,
+                pp.tablename,
+                pp.policyname,
+                pp.permissive,
+                pp.roles,
+                pp.cmd,
+                pp.qual,
+                pp.with_check,
+                obj_description(pol.oid, 'pg_policy') AS comment
+            FROM pg_catalog.pg_policies pp
+            JOIN pg_catalog.pg_namespace n ON n.nspname = pp.schemaname
+            JOIN pg_catalog.pg_class c ON c.relnamespace = n.oid AND c.relname = pp.tablename
+            JOIN pg_catalog.pg_policy pol ON pol.polrelid = c.oid AND pol.polname = pp.policyname
+            WHERE %s
+            ORDER BY pp.schemaname, pp.tablename, pp.policyname;
+        `,
+		s.buildSchemaCond("pp.schemaname"),
+	)
+	rows, err := s.dbClient.Execute(policiesQuery)
+	if err != nil {
+		return fmt.Errorf("query policies: %w", err)
+	}
+	defer rows.Rows.Close()
+	for rows.Rows.Next() {
+		var (
+			schemaName, tableName, policyName string
+			permissive, command               string
+			roles                             []string
+			using, withCheck, comment         sql.NullString
+		)
+		if err := rows.Rows.Scan(
+			&schemaName,
+			&tableName,
+			&policyName,
+			&permissive,
+			pq.Array(&roles),
+			&command,
+			&using,
+			&withCheck,
+			&comment,
+		); err != nil {
+			return fmt.Errorf("scan policy row: %w", err)
+		}
+		snapshot.Policies[qualifiedName(schemaName, tableName, policyName)] = driver.PolicyDefinition{
+			TableName:  tableName,
+			Permissive: permissive,
+			Roles:      roles,
+			Command:    command,
+			Using:      using,
+			WithCheck:  withCheck,
+			Comment:    comment,
+		}
+	}
+	if err := rows.Rows.Err(); err != nil {
+		return fmt.Errorf("iterate policy rows: %w", err)
+	}
+	return nil
+}
+
+// scanExtensions captures every installed extension: extensions are database-wide,
+// so a leaked one matters regardless of the schema it was installed into.
+func (s *StaircaseWorker) scanExtensions(snapshot *driver.SchemaSnapshot) error {
+	rows, err := s.dbClient.Execute(`
+            SELECT
+                e.extname,
+                e.extversion,
+                n.nspname
+            FROM pg_catalog.pg_extension e
+            JOIN pg_catalog.pg_namespace n ON n.oid = e.extnamespace
+            ORDER BY e.extname;
+        `)
+	if err != nil {
+		return fmt.Errorf("query extensions: %w", err)
+	}
+	defer rows.Rows.Close()
+	for rows.Rows.Next() {
+		var name, version, schemaName string
+		if err := rows.Rows.Scan(&name, &version, &schemaName); err != nil {
+			return fmt.Errorf("scan extension row: %w", err)
+		}
+		snapshot.Extensions[name] = driver.ExtensionDefinition{
+			Version: version,
+			Schema:  schemaName,
+		}
+	}
+	if err := rows.Rows.Err(); err != nil {
+		return fmt.Errorf("iterate extension rows: %w", err)
+	}
+	return nil
+}
+
+// scanEventTriggers captures database-wide event triggers, independent of --schema.
+func (s *StaircaseWorker) scanEventTriggers(snapshot *driver.SchemaSnapshot) error {
+	rows, err := s.dbClient.Execute(`
+            SELECT
+                e.evtname,
+                e.evtevent,
+                e.evtfoid::regprocedure::text AS function,
+                CASE e.evtenabled
+                    WHEN 'O' THEN 'ORIGIN'
+                    WHEN 'D' THEN 'DISABLED'
+                    WHEN 'R' THEN 'REPLICA'
+                    WHEN 'A' THEN 'ALWAYS'
+                END AS enabled,
+                e.evttags,
+                pg_get_userbyid(e.evtowner) AS owner
+            FROM pg_catalog.pg_event_trigger e
+            ORDER BY e.evtname;
+        `)
+	if err != nil {
+		return fmt.Errorf("query event triggers: %w", err)
+	}
+	defer rows.Rows.Close()
+	for rows.Rows.Next() {
+		var (
+			name, event, function, enabled, owner string
+			tags                                  []string
+		)
+		if err := rows.Rows.Scan(
+			&name,
+			&event,
+			&function,
+			&enabled,
+			pq.Array(&tags),
+			&owner,
+		); err != nil {
+			return fmt.Errorf("scan event trigger row: %w", err)
+		}
+		snapshot.EventTriggers[name] = driver.EventTriggerDefinition{
+			Event:    event,
+			Function: function,
+			Enabled:  enabled,
+			Tags:     tags,
+			Owner:    owner,
+		}
+	}
+	if err := rows.Rows.Err(); err != nil {
+		return fmt.Errorf("iterate event trigger rows: %w", err)
+	}
+	return nil
+}
+
+func (s *StaircaseWorker) scanRules(snapshot *driver.SchemaSnapshot) error {
+	rulesQuery := fmt.Sprintf(
+		`
+            SELECT
+                n.nspname,
+                c.relname,
+                r.rulename,
+                pg_get_ruledef(r.oid, true) AS definition,
+                CASE r.ev_enabled
+                    WHEN 'O' THEN 'ORIGIN'
+                    WHEN 'D' THEN 'DISABLED'
+                    WHEN 'R' THEN 'REPLICA'
+                    WHEN 'A' THEN 'ALWAYS'
+                END AS enabled
+            FROM pg_catalog.pg_rewrite r
+            JOIN pg_catalog.pg_class c ON c.oid = r.ev_class
+            JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+            WHERE r.rulename <> '_RETURN'
+              AND %s
+            ORDER BY n.nspname, c.relname, r.rulename;
+        `,
+		s.buildSchemaCond("n.nspname"),
+	)
+	rows, err := s.dbClient.Execute(rulesQuery)
+	if err != nil {
+		return fmt.Errorf("query rules: %w", err)
+	}
+	defer rows.Rows.Close()
+	for rows.Rows.Next() {
+		var schemaName, tableName, ruleName, definition, enabled string
+		if err := rows.Rows.Scan(
+			&schemaName,
+			&tableName,
+			&ruleName,
+			&definition,
+			&enabled,
+		); err != nil {
+			return fmt.Errorf("scan rule row: %w", err)
+		}
+		snapshot.Rules[qualifiedName(schemaName, tableName, ruleName)] = driver.RuleDefinition{
+			TableName:  tableName,
+			Definition: definition,
+			Enabled:    enabled,
+		}
 	}
+	if err := rows.Rows.Err(); err != nil {
+		return fmt.Errorf("iterate rule rows: %w", err)
+	}
+	return nil
+}
+
+// scanPublications captures every publication with its explicitly added tables.
+// Row filters and column lists are only available since PostgreSQL 15.
+func (s *StaircaseWorker) scanPublications(snapshot *driver.SchemaSnapshot) error {
+	rowFilter, columnList := "NULL::text", "NULL::text[]"
+	if s.serverVersion >= pg15VersionNum {
+		rowFilter = "pg_get_expr(pr.prqual, pr.prrelid)"
+		columnList = `
+                CASE WHEN pr.prattrs IS NULL THEN NULL ELSE ARRAY(
+                    SELECT a.attname
+                    FROM pg_catalog.pg_attribute a
+                    WHERE a.attrelid = pr.prrelid
+                      AND a.attnum = ANY(pr.prattrs)
+                    ORDER BY a.attnum
+                ) END`
+	}
+	publicationsQuery := fmt.Sprintf(
+		`
+            SELECT
+                p.pubname,
+                pg_get_userbyid(p.pubowner) AS owner,
+                p.puballtables,
+                p.pubinsert,
+                p.pubupdate,
+                p.pubdelete,
+                p.pubtruncate,
+                p.pubviaroot,
+                n.nspname,
+                c.relname,
+                %s AS row_filter,
+                %s AS column_list
+            FROM pg_catalog.pg_publication p
+            LEFT JOIN pg_catalog.pg_publication_rel pr ON pr.prpubid = p.oid
+            LEFT JOIN pg_catalog.pg_class c ON c.oid = pr.prrelid
+            LEFT JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+            ORDER BY p.pubname, n.nspname, c.relname;
+        `,
+		rowFilter,
+		columnList,
+	)
+	rows, err := s.dbClient.Execute(publicationsQuery)
+	if err != nil {
+		return fmt.Errorf("query publications: %w", err)
+	}
+	defer rows.Rows.Close()
+	for rows.Rows.Next() {
+		var (
+			name, owner                    string
+			allTables, viaRoot             bool
+			insert, update, del, truncate  bool
+			schemaName, tableName, rowQual sql.NullString
+			columns                        []string
+		)
+		if err := rows.Rows.Scan(
+			&name,
+			&owner,
+			&allTables,
+			&insert,
+			&update,
+			&del,
+			&truncate,
+			&viaRoot,
+			&schemaName,
+			&tableName,
+			&rowQual,
+			pq.Array(&columns),
+		); err != nil {
+			return fmt.Errorf("scan publication row: %w", err)
+		}
+		pub, ok := snapshot.Publications[name]
+		if !ok {
+			pub = driver.PublicationDefinition{
+				Owner:     owner,
+				AllTables: allTables,
+				Insert:    insert,
+				Update:    update,
+				Delete:    del,
+				Truncate:  truncate,
+				ViaRoot:   viaRoot,
+				Tables:    make(map[string]driver.PublicationTableDefinition),
+			}
+		}
+		if tableName.Valid {
+			pub.Tables[qualifiedName(schemaName.String, tableName.String)] = driver.PublicationTableDefinition{
+				RowFilter: rowQual,
+				Columns:   columns,
+			}
+		}
+		snapshot.Publications[name] = pub
+	}
+	if err := rows.Rows.Err(); err != nil {
+		return fmt.Errorf("iterate publication rows: %w", err)
+	}
+	return nil
+}
+
+// scanPublicationSchemas fills FOR TABLES IN SCHEMA members, available since PostgreSQL 15.
+func (s *StaircaseWorker) scanPublicationSchemas(snapshot *driver.SchemaSnapshot) error {
+	if s.serverVersion < pg15VersionNum {
+		return nil
+	}
+	rows, err := s.dbClient.Execute(`
+            SELECT
+                p.pubname,
+                n.nspname
+            FROM pg_catalog.pg_publication_namespace pn
+            JOIN pg_catalog.pg_publication p ON p.oid = pn.pnpubid
+            JOIN pg_catalog.pg_namespace n ON n.oid = pn.pnnspid
+            ORDER BY p.pubname, n.nspname;
+        `)
+	if err != nil {
+		return fmt.Errorf("query publication schemas: %w", err)
+	}
+	defer rows.Rows.Close()
+	for rows.Rows.Next() {
+		var name, schemaName string
+		if err := rows.Rows.Scan(&name, &schemaName); err != nil {
+			return fmt.Errorf("scan publication schema row: %w", err)
+		}
+		pub := snapshot.Publications[name]
+		pub.Schemas = append(pub.Schemas, schemaName)
+		snapshot.Publications[name] = pub
+	}
+	if err := rows.Rows.Err(); err != nil {
+		return fmt.Errorf("iterate publication schema rows: %w", err)
+	}
+	return nil
+}
+
+func (s *StaircaseWorker) scanStatistics(snapshot *driver.SchemaSnapshot) error {
+	statisticsQuery := fmt.Sprintf(
+		`
+            SELECT
+                n.nspname,
+                st.stxname,
+                c.relname,
+                pg_get_statisticsobjdef(st.oid) AS definition,
+                NULLIF(st.stxstattarget, -1) AS statistics_target,
+                pg_get_userbyid(st.stxowner) AS owner
+            FROM pg_catalog.pg_statistic_ext st
+            JOIN pg_catalog.pg_namespace n ON n.oid = st.stxnamespace
+            JOIN pg_catalog.pg_class c ON c.oid = st.stxrelid
+            WHERE %s
+            ORDER BY n.nspname, st.stxname;
+        `,
+		s.buildSchemaCond("n.nspname"),
+	)
+	rows, err := s.dbClient.Execute(statisticsQuery)
+	if err != nil {
+		return fmt.Errorf("query extended statistics: %w", err)
+	}
+	defer rows.Rows.Close()
+	for rows.Rows.Next() {
+		var (
+			schemaName, name, tableName string
+			definition, owner           string
+			statisticsTarget            sql.NullInt64
+		)
+		if err := rows.Rows.Scan(
+			&schemaName,
+			&name,
+			&tableName,
+			&definition,
+			&statisticsTarget,
+			&owner,
+		); err != nil {
+			return fmt.Errorf("scan extended statistics row: %w", err)
+		}
+		snapshot.Statistics[qualifiedName(schemaName, name)] = driver.StatisticsDefinition{
+			TableName:        tableName,
+			Definition:       definition,
+			StatisticsTarget: statisticsTarget,
+			Owner:            owner,
+		}
+	}
+	if err := rows.Rows.Err(); err != nil {
+		return fmt.Errorf("iterate extended statistics rows: %w", err)
+	}
+	return nil
+}
+
+func (s *StaircaseWorker) scanCollations(snapshot *driver.SchemaSnapshot) error {
+	// The ICU locale column was added in PostgreSQL 15 and renamed in 17.
+	locale := "NULL::text"
+	switch {
+	case s.serverVersion >= pg17VersionNum:
+		locale = "coll.colllocale"
+	case s.serverVersion >= pg15VersionNum:
+		locale = "coll.colliculocale"
+	}
+	collationsQuery := fmt.Sprintf(
+		`
+            SELECT
+                n.nspname,
+                coll.collname,
+                CASE coll.collprovider
+                    WHEN 'd' THEN 'default'
+                    WHEN 'c' THEN 'libc'
+                    WHEN 'i' THEN 'icu'
+                    WHEN 'b' THEN 'builtin'
+                END AS provider,
+                coll.collisdeterministic,
+                coll.collcollate,
+                coll.collctype,
+                %s AS locale,
+                pg_get_userbyid(coll.collowner) AS owner
+            FROM pg_catalog.pg_collation coll
+            JOIN pg_catalog.pg_namespace n ON n.oid = coll.collnamespace
+            WHERE %s
+            ORDER BY n.nspname, coll.collname;
+        `,
+		locale,
+		s.buildSchemaCond("n.nspname"),
+	)
+	rows, err := s.dbClient.Execute(collationsQuery)
+	if err != nil {
+		return fmt.Errorf("query collations: %w", err)
+	}
+	defer rows.Rows.Close()
+	for rows.Rows.Next() {
+		var (
+			schemaName, name, provider, owner string
+			deterministic                     bool
+			collate, ctype, collLocale        sql.NullString
+		)
+		if err := rows.Rows.Scan(
+			&schemaName,
+			&name,
+			&provider,
+			&deterministic,
+			&collate,
+			&ctype,
+			&collLocale,
+			&owner,
+		); err != nil {
+			return fmt.Errorf("scan collation row: %w", err)
+		}
+		snapshot.Collations[qualifiedName(schemaName, name)] = driver.CollationDefinition{
+			Provider:      provider,
+			Deterministic: deterministic,
+			Collate:       collate,
+			Ctype:         ctype,
+			Locale:        collLocale,
+			Owner:         owner,
+		}
+	}
+	if err := rows.Rows.Err(); err != nil {
+		return fmt.Errorf("iterate collation rows: %w", err)
+	}
+	return nil
+}
+
+func (s *StaircaseWorker) scanTextSearchConfigs(snapshot *driver.SchemaSnapshot) error {
+	configsQuery := fmt.Sprintf(
+		`
+            SELECT
+                n.nspname,
+                cfg.cfgname,
+                pn.nspname || '.' || prs.prsname AS parser,
+                pg_get_userbyid(cfg.cfgowner) AS owner,
+                tt.alias AS token_type,
+                dn.nspname || '.' || d.dictname AS dictionary
+            FROM pg_catalog.pg_ts_config cfg
+            JOIN pg_catalog.pg_namespace n ON n.oid = cfg.cfgnamespace
+            JOIN pg_catalog.pg_ts_parser prs ON prs.oid = cfg.cfgparser
+            JOIN pg_catalog.pg_namespace pn ON pn.oid = prs.prsnamespace
+            LEFT JOIN pg_catalog.pg_ts_config_map m ON m.mapcfg = cfg.oid
+            LEFT JOIN LATERAL ts_token_type(cfg.cfgparser) tt ON tt.tokid = m.maptokentype
+            LEFT JOIN pg_catalog.pg_ts_dict d ON d.oid = m.mapdict
+            LEFT JOIN pg_catalog.pg_namespace dn ON dn.oid = d.dictnamespace
+            WHERE %s
+            ORDER BY n.nspname, cfg.cfgname, tt.alias, m.mapseqno;
+        `,
+		s.buildSchemaCond("n.nspname"),
+	)
+	rows, err := s.dbClient.Execute(configsQuery)
+	if err != nil {
+		return fmt.Errorf("query text search configurations: %w", err)
+	}
+	defer rows.Rows.Close()
+	for rows.Rows.Next() {
+		var (
+			schemaName, name, parser, owner string
+			tokenType, dictionary           sql.NullString
+		)
+		if err := rows.Rows.Scan(
+			&schemaName,
+			&name,
+			&parser,
+			&owner,
+			&tokenType,
+			&dictionary,
+		); err != nil {
+			return fmt.Errorf("scan text search configuration row: %w", err)
+		}
+		key := qualifiedName(schemaName, name)
+		cfg, ok := snapshot.TSConfigs[key]
+		if !ok {
+			cfg = driver.TextSearchConfigDefinition{
+				Parser:   parser,
+				Owner:    owner,
+				Mappings: make(map[string][]string),
+			}
+		}
+		if tokenType.Valid {
+			cfg.Mappings[tokenType.String] = append(cfg.Mappings[tokenType.String], dictionary.String)
+		}
+		snapshot.TSConfigs[key] = cfg
+	}
+	if err := rows.Rows.Err(); err != nil {
+		return fmt.Errorf("iterate text search configuration rows: %w", err)
+	}
+	return nil
+}
+
+func (s *StaircaseWorker) scanTextSearchDicts(snapshot *driver.SchemaSnapshot) error {
+	dictsQuery := fmt.Sprintf(
+		`
+            SELECT
+                n.nspname,
+                d.dictname,
+                tn.nspname || '.' || t.tmplname AS template,
+                d.dictinitoption,
+                pg_get_userbyid(d.dictowner) AS owner
+            FROM pg_catalog.pg_ts_dict d
+            JOIN pg_catalog.pg_namespace n ON n.oid = d.dictnamespace
+            JOIN pg_catalog.pg_ts_template t ON t.oid = d.dicttemplate
+            JOIN pg_catalog.pg_namespace tn ON tn.oid = t.tmplnamespace
+            WHERE %s
+            ORDER BY n.nspname, d.dictname;
+        `,
+		s.buildSchemaCond("n.nspname"),
+	)
+	rows, err := s.dbClient.Execute(dictsQuery)
+	if err != nil {
+		return fmt.Errorf("query text search dictionaries: %w", err)
+	}
+	defer rows.Rows.Close()
+	for rows.Rows.Next() {
+		var (
+			schemaName, name, template, owner string
+			options                           sql.NullString
+		)
+		if err := rows.Rows.Scan(
+			&schemaName,
+			&name,
+			&template,
+			&options,
+			&owner,
+		); err != nil {
+			return fmt.Errorf("scan text search dictionary row: %w", err)
+		}
+		snapshot.TSDicts[qualifiedName(schemaName, name)] = driver.TextSearchDictDefinition{
+			Template: template,
+			Options:  options,
+			Owner:    owner,
+		}
+	}
+	if err := rows.Rows.Err(); err != nil {
+		return fmt.Errorf("iterate text search dictionary rows: %w", err)
+	}
+	return nil
+}
+
+func (s *StaircaseWorker) scanForeignDataWrappers(snapshot *driver.SchemaSnapshot) error {
+	rows, err := s.dbClient.Execute(`
+            SELECT
+                w.fdwname,
+                CASE WHEN w.fdwhandler = 0 THEN NULL ELSE w.fdwhandler::regproc::text END AS handler,
+                CASE WHEN w.fdwvalidator = 0 THEN NULL ELSE w.fdwvalidator::regproc::text END AS validator,
+                w.fdwoptions,
+                pg_get_userbyid(w.fdwowner) AS owner
+            FROM pg_catalog.pg_foreign_data_wrapper w
+            ORDER BY w.fdwname;
+        `)
+	if err != nil {
+		return fmt.Errorf("query foreign data wrappers: %w", err)
+	}
+	defer rows.Rows.Close()
+	for rows.Rows.Next() {
+		var (
+			name, owner        string
+			handler, validator sql.NullString
+			options            []string
+		)
+		if err := rows.Rows.Scan(
+			&name,
+			&handler,
+			&validator,
+			pq.Array(&options),
+			&owner,
+		); err != nil {
+			return fmt.Errorf("scan foreign data wrapper row: %w", err)
+		}
+		snapshot.ForeignDataWrappers[name] = driver.ForeignDataWrapperDefinition{
+			Handler:   handler,
+			Validator: validator,
+			Options:   options,
+			Owner:     owner,
+		}
+	}
+	if err := rows.Rows.Err(); err != nil {
+		return fmt.Errorf("iterate foreign data wrapper rows: %w", err)
+	}
+	return nil
+}
+
+func (s *StaircaseWorker) scanForeignServers(snapshot *driver.SchemaSnapshot) error {
+	rows, err := s.dbClient.Execute(`
+            SELECT
+                fs.srvname,
+                w.fdwname,
+                fs.srvtype,
+                fs.srvversion,
+                fs.srvoptions,
+                pg_get_userbyid(fs.srvowner) AS owner
+            FROM pg_catalog.pg_foreign_server fs
+            JOIN pg_catalog.pg_foreign_data_wrapper w ON w.oid = fs.srvfdw
+            ORDER BY fs.srvname;
+        `)
+	if err != nil {
+		return fmt.Errorf("query foreign servers: %w", err)
+	}
+	defer rows.Rows.Close()
+	for rows.Rows.Next() {
+		var (
+			name, wrapper, owner  string
+			serverType, serverVer sql.NullString
+			options               []string
+		)
+		if err := rows.Rows.Scan(
+			&name,
+			&wrapper,
+			&serverType,
+			&serverVer,
+			pq.Array(&options),
+			&owner,
+		); err != nil {
+			return fmt.Errorf("scan foreign server row: %w", err)
+		}
+		snapshot.ForeignServers[name] = driver.ForeignServerDefinition{
+			Wrapper: wrapper,
+			Type:    serverType,
+			Version: serverVer,
+			Options: options,
+			Owner:   owner,
+		}
+	}
+	if err := rows.Rows.Err(); err != nil {
+		return fmt.Errorf("iterate foreign server rows: %w", err)
+	}
+	return nil
+}
+
+// scanUserMappings keys mappings by "server.user"; umoptions are only visible
+// to the mapped user, the server owner or superusers.
+func (s *StaircaseWorker) scanUserMappings(snapshot *driver.SchemaSnapshot) error {
+	rows, err := s.dbClient.Execute(`
+            SELECT
+                srvname,
+                usename,
+                umoptions
+            FROM pg_catalog.pg_user_mappings
+            ORDER BY srvname, usename;
+        `)
+	if err != nil {
+		return fmt.Errorf("query user mappings: %w", err)
+	}
+	defer rows.Rows.Close()
+	for rows.Rows.Next() {
+		var (
+			serverName, userName string
+			options              []string
+		)
+		if err := rows.Rows.Scan(&serverName, &userName, pq.Array(&options)); err != nil {
+			return fmt.Errorf("scan user mapping row: %w", err)
+		}
+		snapshot.UserMappings[qualifiedName(serverName, userName)] = driver.UserMappingDefinition{
+			Options: options,
+		}
+	}
+	if err := rows.Rows.Err(); err != nil {
+		return fmt.Errorf("iterate user mapping rows: %w", err)
+	}
+	return nil
+}
+
+func (s *StaircaseWorker) scanForeignTables(snapshot *driver.SchemaSnapshot) error {
+	foreignTablesQuery := fmt.Sprintf(
+		`
+            SELECT
+                n.nspname,
+                c.relname,
+                fs.srvname,
+                ft.ftoptions,
+                a.attname,
+                a.attfdwoptions
+            FROM pg_catalog.pg_foreign_table ft
+            JOIN pg_catalog.pg_class c ON c.oid = ft.ftrelid
+            JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+            JOIN pg_catalog.pg_foreign_server fs ON fs.oid = ft.ftserver
+            LEFT JOIN pg_catalog.pg_attribute a
+                   ON a.attrelid = c.oid
+                  AND a.attnum > 0
+                  AND NOT a.attisdropped
+                  AND a.attfdwoptions IS NOT NULL
+            WHERE %s
+            ORDER BY n.nspname, c.relname, a.attnum;
+        `,
+		s.buildSchemaCond("n.nspname"),
+	)
+	rows, err := s.dbClient.Execute(foreignTablesQuery)
+	if err != nil {
+		return fmt.Errorf("query foreign tables: %w", err)
+	}
+	defer rows.Rows.Close()
+	for rows.Rows.Next() {
+		var (
+			schemaName, tableName, serverName string
+			columnName                        sql.NullString
+			options, columnOptions            []string
+		)
+		if err := rows.Rows.Scan(
+			&schemaName,
+			&tableName,
+			&serverName,
+			pq.Array(&options),
+			&columnName,
+			pq.Array(&columnOptions),
+		); err != nil {
+			return fmt.Errorf("scan foreign table row: %w", err)
+		}
+		key := qualifiedName(schemaName, tableName)
+		ft, ok := snapshot.ForeignTables[key]
+		if !ok {
+			ft = driver.ForeignTableDefinition{
+				Server:        serverName,
+				Options:       options,
+				ColumnOptions: make(map[string][]string),
+			}
+		}
+		if columnName.Valid {
+			ft.ColumnOptions[columnName.String] = columnOptions
+		}
+		snapshot.ForeignTables[key] = ft
+	}
+	if err := rows.Rows.Err(); err != nil {
+		return fmt.Errorf("iterate foreign table rows: %w", err)
+	}
+	return nil
+}
+
+// scanAggregates captures user-defined aggregates, which scanFunctions skips.
+func (s *StaircaseWorker) scanAggregates(snapshot *driver.SchemaSnapshot) error {
+	aggregatesQuery := fmt.Sprintf(
+		`
+            SELECT
+                n.nspname,
+                p.proname,
+                pg_get_function_identity_arguments(p.oid) AS identity_arguments,
+                CASE a.aggkind
+                    WHEN 'n' THEN 'normal'
+                    WHEN 'o' THEN 'ordered-set'
+                    WHEN 'h' THEN 'hypothetical'
+                END AS kind,
+                a.aggtransfn::oid::regprocedure::text AS transition_function,
+                NULLIF(a.aggfinalfn::oid, 0)::regprocedure::text AS final_function,
+                NULLIF(a.aggcombinefn::oid, 0)::regprocedure::text AS combine_function,
+                NULLIF(a.aggsortop, 0)::regoperator::text AS sort_operator,
+                format_type(a.aggtranstype, NULL) AS state_type,
+                a.agginitval,
+                pg_get_function_result(p.oid) AS return_type,
+                obj_description(p.oid, 'pg_proc') AS comment,
+                pg_get_userbyid(p.proowner) AS owner
+            FROM pg_catalog.pg_aggregate a
+            JOIN pg_catalog.pg_proc p ON p.oid = a.aggfnoid
+            JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+            WHERE %s
+            ORDER BY n.nspname, p.proname, identity_arguments;
+        `,
+		s.buildSchemaCond("n.nspname"),
+	)
+	rows, err := s.dbClient.Execute(aggregatesQuery)
+	if err != nil {
+		return fmt.Errorf("query aggregates: %w", err)
+	}
+	defer rows.Rows.Close()
+	for rows.Rows.Next() {
+		var (
+			schemaName, name, identityArgs    string
+			agg                               driver.AggregateDefinition
+			finalFunc, combineFunc, sortOp    sql.NullString
+			initialValue, returnType, comment sql.NullString
+		)
+		if err := rows.Rows.Scan(
+			&schemaName,
+			&name,
+			&identityArgs,
+			&agg.Kind,
+			&agg.TransitionFunction,
+			&finalFunc,
+			&combineFunc,
+			&sortOp,
+			&agg.StateType,
+			&initialValue,
+			&returnType,
+			&comment,
+			&agg.Owner,
+		); err != nil {
+			return fmt.Errorf("scan aggregate row: %w", err)
+		}
+		agg.FinalFunction = finalFunc
+		agg.CombineFunction = combineFunc
+		agg.SortOperator = sortOp
+		agg.InitialValue = initialValue
+		agg.ReturnType = returnType.String
+		agg.Comment = comment
+		snapshot.Aggregates[qualifiedName(schemaName, fmt.Sprintf("%s(%s)", name, identityArgs))] = agg
+	}
+	if err := rows.Rows.Err(); err != nil {
+		return fmt.Errorf("iterate aggregate rows: %w", err)
+	}
+	return nil
+}
+
+func (s *StaircaseWorker) scanOperators(snapshot *driver.SchemaSnapshot) error {
+	operatorsQuery := fmt.Sprintf(
+		`
+            SELECT
+                n.nspname,
+                o.oprname,
+                COALESCE(format_type(NULLIF(o.oprleft, 0), NULL), 'NONE') AS left_type,
+                COALESCE(format_type(NULLIF(o.oprright, 0), NULL), 'NONE') AS right_type,
+                format_type(o.oprresult, NULL) AS result_type,
+                NULLIF(o.oprcode::oid, 0)::regprocedure::text AS function,
+                NULLIF(o.oprcom, 0)::regoperator::text AS commutator,
+                NULLIF(o.oprnegate, 0)::regoperator::text AS negator,
+                NULLIF(o.oprrest::oid, 0)::regproc::text AS restrict_estimator,
+                NULLIF(o.oprjoin::oid, 0)::regproc::text AS join_estimator,
+                o.oprcanhash,
+                o.oprcanmerge,
+                obj_description(o.oid, 'pg_operator') AS comment,
+                pg_get_userbyid(o.oprowner) AS owner
+            FROM pg_catalog.pg_operator o
+            JOIN pg_catalog.pg_namespace n ON n.oid = o.oprnamespace
+            WHERE %s
+            ORDER BY n.nspname, o.oprname, left_type, right_type;
+        `,
+		s.buildSchemaCond("n.nspname"),
+	)
+	rows, err := s.dbClient.Execute(operatorsQuery)
+	if err != nil {
+		return fmt.Errorf("query operators: %w", err)
+	}
+	defer rows.Rows.Close()
+	for rows.Rows.Next() {
+		var (
+			schemaName, name string
+			op               driver.OperatorDefinition
+		)
+		if err := rows.Rows.Scan(
+			&schemaName,
+			&name,
+			&op.LeftType,
+			&op.RightType,
+			&op.ResultType,
+			&op.Function,
+			&op.Commutator,
+			&op.Negator,
+			&op.Restrict,
+			&op.Join,
+			&op.CanHash,
+			&op.CanMerge,
+			&op.Comment,
+			&op.Owner,
+		); err != nil {
+			return fmt.Errorf("scan operator row: %w", err)
+		}
+		signature := fmt.Sprintf("%s(%s, %s)", name, op.LeftType, op.RightType)
+		snapshot.Operators[qualifiedName(schemaName, signature)] = op
+	}
+	if err := rows.Rows.Err(); err != nil {
+		return fmt.Errorf("iterate operator rows: %w", err)
+	}
+	return nil
+}
+
+// scanCasts captures user-defined casts (oid >= firstNormalObjectID), which are database-wide.
+func (s *StaircaseWorker) scanCasts(snapshot *driver.SchemaSnapshot) error {
+	castsQuery := fmt.Sprintf(
+		`
+            SELECT
+                format_type(c.castsource, NULL) AS source,
+                format_type(c.casttarget, NULL) AS target,
+                NULLIF(c.castfunc, 0)::regprocedure::text AS function,
+                CASE c.castcontext
+                    WHEN 'e' THEN 'EXPLICIT'
+                    WHEN 'a' THEN 'ASSIGNMENT'
+                    WHEN 'i' THEN 'IMPLICIT'
+                END AS context,
+                CASE c.castmethod
+                    WHEN 'f' THEN 'FUNCTION'
+                    WHEN 'i' THEN 'INOUT'
+                    WHEN 'b' THEN 'BINARY'
+                END AS method,
+                obj_description(c.oid, 'pg_cast') AS comment
+            FROM pg_catalog.pg_cast c
+            WHERE c.oid >= %d
+            ORDER BY source, target;
+        `,
+		firstNormalObjectID,
+	)
+	rows, err := s.dbClient.Execute(castsQuery)
+	if err != nil {
+		return fmt.Errorf("query casts: %w", err)
+	}
+	defer rows.Rows.Close()
+	for rows.Rows.Next() {
+		var (
+			source, target, context, method string
+			function, comment               sql.NullString
+		)
+		if err := rows.Rows.Scan(
+			&source,
+			&target,
+			&function,
+			&context,
+			&method,
+			&comment,
+		); err != nil {
+			return fmt.Errorf("scan cast row: %w", err)
+		}
+		snapshot.Casts[fmt.Sprintf("%s AS %s", source, target)] = driver.CastDefinition{
+			Function: function,
+			Context:  context,
+			Method:   method,
+			Comment:  comment,
+		}
+	}
+	if err := rows.Rows.Err(); err != nil {
+		return fmt.Errorf("iterate cast rows: %w", err)
+	}
+	return nil
+}
+
+func (s *StaircaseWorker) scanOperatorFamilies(snapshot *driver.SchemaSnapshot) error {
+	familiesQuery := fmt.Sprintf(
+		`
+            SELECT
+                n.nspname,
+                f.opfname,
+                am.amname,
+                ARRAY(
+                    SELECT format(
+                        'OPERATOR %%s %%s%%s',
+                        ao.amopstrategy,
+                        ao.amopopr::regoperator,
+                        CASE WHEN ao.amoppurpose = 'o' THEN ' FOR ORDER BY' ELSE '' END
+                    ) AS member
+                    FROM pg_catalog.pg_amop ao
+                    WHERE ao.amopfamily = f.oid
+                    ORDER BY ao.amopstrategy, member
+                ) AS operators,
+                ARRAY(
+                    SELECT format(
+                        'FUNCTION %%s (%%s, %%s) %%s',
+                        ap.amprocnum,
+                        format_type(ap.amproclefttype, NULL),
+                        format_type(ap.amprocrighttype, NULL),
+                        ap.amproc::oid::regprocedure
+                    ) AS member
+                    FROM pg_catalog.pg_amproc ap
+                    WHERE ap.amprocfamily = f.oid
+                    ORDER BY ap.amprocnum, member
+                ) AS functions,
+                pg_get_userbyid(f.opfowner) AS owner
+            FROM pg_catalog.pg_opfamily f
+            JOIN pg_catalog.pg_am am ON am.oid = f.opfmethod
+            JOIN pg_catalog.pg_namespace n ON n.oid = f.opfnamespace
+            WHERE %s
+            ORDER BY n.nspname, f.opfname, am.amname;
+        `,
+		s.buildSchemaCond("n.nspname"),
+	)
+	rows, err := s.dbClient.Execute(familiesQuery)
+	if err != nil {
+		return fmt.Errorf("query operator families: %w", err)
+	}
+	defer rows.Rows.Close()
+	for rows.Rows.Next() {
+		var (
+			schemaName, name, method, owner string
+			operators, functions            []string
+		)
+		if err := rows.Rows.Scan(
+			&schemaName,
+			&name,
+			&method,
+			pq.Array(&operators),
+			pq.Array(&functions),
+			&owner,
+		); err != nil {
+			return fmt.Errorf("scan operator family row: %w", err)
+		}
+		snapshot.OperatorFamilies[qualifiedName(schemaName, name+" USING "+method)] = driver.OperatorFamilyDefinition{
+			Method:    method,
+			Operators: operators,
+			Functions: functions,
+			Owner:     owner,
+		}
+	}
+	if err := rows.Rows.Err(); err != nil {
+		return fmt.Errorf("iterate operator family rows: %w", err)
+	}
+	return nil
+}
+
+func (s *StaircaseWorker) scanOperatorClasses(snapshot *driver.SchemaSnapshot) error {
+	classesQuery := fmt.Sprintf(
+		`
+            SELECT
+                n.nspname,
+                c.opcname,
+                am.amname,
+                fn.nspname || '.' || f.opfname AS family,
+                format_type(c.opcintype, NULL) AS input_type,
+                CASE WHEN c.opckeytype <> 0 THEN format_type(c.opckeytype, NULL) END AS storage_type,
+                c.opcdefault,
+                pg_get_userbyid(c.opcowner) AS owner
+            FROM pg_catalog.pg_opclass c
+            JOIN pg_catalog.pg_am am ON am.oid = c.opcmethod
+            JOIN pg_catalog.pg_opfamily f ON f.oid = c.opcfamily
+            JOIN pg_catalog.pg_namespace fn ON fn.oid = f.opfnamespace
+            JOIN pg_catalog.pg_namespace n ON n.oid = c.opcnamespace
+            WHERE %s
+            ORDER BY n.nspname, c.opcname, am.amname;
+        `,
+		s.buildSchemaCond("n.nspname"),
+	)
+	rows, err := s.dbClient.Execute(classesQuery)
+	if err != nil {
+		return fmt.Errorf("query operator classes: %w", err)
+	}
+	defer rows.Rows.Close()
+	for rows.Rows.Next() {
+		var (
+			schemaName, name string
+			opc              driver.OperatorClassDefinition
+		)
+		if err := rows.Rows.Scan(
+			&schemaName,
+			&name,
+			&opc.Method,
+			&opc.Family,
+			&opc.InputType,
+			&opc.StorageType,
+			&opc.IsDefault,
+			&opc.Owner,
+		); err != nil {
+			return fmt.Errorf("scan operator class row: %w", err)
+		}
+		snapshot.OperatorClasses[qualifiedName(schemaName, name+" USING "+opc.Method)] = opc
+	}
+	if err := rows.Rows.Err(); err != nil {
+		return fmt.Errorf("iterate operator class rows: %w", err)
+	}
+	return nil
+}
+
+// scanRoles captures cluster-wide roles, skipping the predefined pg_* ones. Only enabled by --test-cluster-objects.
+func (s *StaircaseWorker) scanRoles(snapshot *driver.SchemaSnapshot) error {
+	rows, err := s.dbClient.Execute(`
+            SELECT
+                r.rolname,
+                r.rolsuper,
+                r.rolinherit,
+                r.rolcreaterole,
+                r.rolcreatedb,
+                r.rolcanlogin,
+                r.rolreplication,
+                r.rolbypassrls,
+                r.rolconnlimit,
+                r.rolvaliduntil::text,
+                ARRAY(SELECT unnest(r.rolconfig) ORDER BY 1) AS config
+            FROM pg_catalog.pg_roles r
+            WHERE r.rolname NOT LIKE 'pg\_%'
+            ORDER BY r.rolname;
+        `)
+	if err != nil {
+		return fmt.Errorf("query roles: %w", err)
+	}
+	defer rows.Rows.Close()
+	for rows.Rows.Next() {
+		var (
+			name       string
+			role       driver.RoleDefinition
+			validUntil sql.NullString
+			config     []string
+		)
+		if err := rows.Rows.Scan(
+			&name,
+			&role.Superuser,
+			&role.Inherit,
+			&role.CreateRole,
+			&role.CreateDB,
+			&role.CanLogin,
+			&role.Replication,
+			&role.BypassRLS,
+			&role.ConnectionLimit,
+			&validUntil,
+			pq.Array(&config),
+		); err != nil {
+			return fmt.Errorf("scan role row: %w", err)
+		}
+		role.ValidUntil = validUntil
+		role.Config = config
+		snapshot.Roles[name] = role
+	}
+	if err := rows.Rows.Err(); err != nil {
+		return fmt.Errorf("iterate role rows: %w", err)
+	}
+	return nil
+}
+
+// scanRoleMemberships captures GRANT role TO member for roles outside the predefined pg_* set.
+func (s *StaircaseWorker) scanRoleMemberships(snapshot *driver.SchemaSnapshot) error {
+	// inherit_option and set_option appeared in PostgreSQL 16.
+	inheritOption, setOption := "NULL::bool", "NULL::bool"
+	if s.serverVersion >= pg16VersionNum {
+		inheritOption, setOption = "m.inherit_option", "m.set_option"
+	}
+	rows, err := s.dbClient.Execute(fmt.Sprintf(
+		`
+            SELECT
+                r.rolname AS role,
+                mr.rolname AS member,
+                pg_get_userbyid(m.grantor) AS grantor,
+                m.admin_option,
+                %s AS inherit_option,
+                %s AS set_option
+            FROM pg_catalog.pg_auth_members m
+            JOIN pg_catalog.pg_roles r ON r.oid = m.roleid
+            JOIN pg_catalog.pg_roles mr ON mr.oid = m.member
+            WHERE r.rolname NOT LIKE 'pg\_%%'
+               OR mr.rolname NOT LIKE 'pg\_%%'
+            ORDER BY r.rolname, mr.rolname, grantor;
+        `,
+		inheritOption,
+		setOption,
+	))
+	if err != nil {
+		return fmt.Errorf("query role memberships: %w", err)
+	}
+	defer rows.Rows.Close()
+	for rows.Rows.Next() {
+		var (
+			role, member, grantor string
+			adminOption           bool
+			inheritOpt, setOpt    sql.NullBool
+		)
+		if err := rows.Rows.Scan(
+			&role,
+			&member,
+			&grantor,
+			&adminOption,
+			&inheritOpt,
+			&setOpt,
+		); err != nil {
+			return fmt.Errorf("scan role membership row: %w", err)
+		}
+		snapshot.RoleMemberships[qualifiedName(role, member, grantor)] = driver.RoleMembershipDefinition{
+			Role:          role,
+			Member:        member,
+			Grantor:       grantor,
+			AdminOption:   adminOption,
+			InheritOption: inheritOpt,
+			SetOption:     setOpt,
+		}
+	}
+	if err := rows.Rows.Err(); err != nil {
+		return fmt.Errorf("iterate role membership rows: %w", err)
+	}
+	return nil
+}
+
+// scanTablespaces captures user tablespaces; pg_default and pg_global are skipped.
+func (s *StaircaseWorker) scanTablespaces(snapshot *driver.SchemaSnapshot) error {
+	rows, err := s.dbClient.Execute(`
+            SELECT
+                t.spcname,
+                pg_get_userbyid(t.spcowner) AS owner,
+                pg_tablespace_location(t.oid) AS location,
+                t.spcoptions
+            FROM pg_catalog.pg_tablespace t
+            WHERE t.spcname NOT LIKE 'pg\_%'
+            ORDER BY t.spcname;
+        `)
+	if err != nil {
+		return fmt.Errorf("query tablespaces: %w", err)
+	}
+	defer rows.Rows.Close()
+	for rows.Rows.Next() {
+		var (
+			name, owner, location string
+			options               []string
+		)
+		if err := rows.Rows.Scan(&name, &owner, &location, pq.Array(&options)); err != nil {
+			return fmt.Errorf("scan tablespace row: %w", err)
+		}
+		snapshot.Tablespaces[name] = driver.TablespaceDefinition{
+			Owner:    owner,
+			Location: location,
+			Options:  options,
+		}
+	}
+	if err := rows.Rows.Err(); err != nil {
+		return fmt.Errorf("iterate tablespace rows: %w", err)
+	}
+	return nil
+}
+
+// scanSettings captures ALTER DATABASE ... SET and ALTER ROLE ... IN DATABASE ... SET entries of the
+// current database. Only enabled by --test-settings; role settings for all databases belong to scanRoles.
+func (s *StaircaseWorker) scanSettings(snapshot *driver.SchemaSnapshot) error {
+	rows, err := s.dbClient.Execute(`
+            SELECT
+                d.datname,
+                r.rolname,
+                ARRAY(SELECT unnest(s.setconfig) ORDER BY 1) AS config
+            FROM pg_catalog.pg_db_role_setting s
+            LEFT JOIN pg_catalog.pg_database d ON d.oid = s.setdatabase
+            LEFT JOIN pg_catalog.pg_roles r ON r.oid = s.setrole
+            WHERE d.datname = current_database()
+            ORDER BY r.rolname NULLS FIRST;
+        `)
+	if err != nil {
+		return fmt.Errorf("query settings: %w", err)
+	}
+	defer rows.Rows.Close()
+	for rows.Rows.Next() {
+		var (
+			database, role sql.NullString
+			config         []string
+		)
+		if err := rows.Rows.Scan(&database, &role, pq.Array(&config)); err != nil {
+			return fmt.Errorf("scan setting row: %w", err)
+		}
+		def := driver.SettingDefinition{Database: database, Role: role, Config: config}
+		snapshot.Settings[qualifiedName(settingScope(database), settingScope(role))] = def
+	}
+	if err := rows.Rows.Err(); err != nil {
+		return fmt.Errorf("iterate setting rows: %w", err)
+	}
+	return nil
+}
+
+// settingScope renders a NULL role of pg_db_role_setting as "*" (applies to all roles).
+func settingScope(name sql.NullString) string {
+	if !name.Valid {
+		return "*"
+	}
+	return name.String
+}
+
+// qualifiedName("public", "users") -> "public.users".
+// qualifiedName("public", "users", "users_pkey") -> "public.users.users_pkey".
+// qualifiedName("", "users") -> "users".
+func qualifiedName(parts ...string) string {
+	nonEmpty := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p != "" {
+			nonEmpty = append(nonEmpty, p)
+		}
+	}
+	return strings.Join(nonEmpty, ".")
+}
+
+// buildSchemaCond("table_schema") -> "table_schema = 'public'".
+// buildSchemaCond("tc.table_schema") -> "tc.table_schema IN ('public','extra')".
+func (s *StaircaseWorker) buildSchemaCond(col string) string {
+	if s.allSchemas {
+		return s.buildUserSchemaCond(col)
+	}
+	list := s.schemas
+	if len(list) == 0 {
+		list = []string{"public"}
+	}
+	if len(list) == 1 {
+		return fmt.Sprintf("%s = %s", col, quoteLiteral(list[0]))
+	}
+	return fmt.Sprintf("%s IN (%s)", col, quoteLiterals(list))
+}
+
+// buildUserSchemaCond matches every non-system schema that is not listed in --exclude-schema.
+func (s *StaircaseWorker) buildUserSchemaCond(col string) string {
+	conds := []string{
+		col + ` NOT LIKE 'pg\_%'`,
+		col + " <> 'information_schema'",
+	}
+	if len(s.excludeSchemas) > 0 {
+		conds = append(conds, fmt.Sprintf("%s NOT IN (%s)", col, quoteLiterals(s.excludeSchemas)))
+	}
+	return "(" + strings.Join(conds, " AND ") + ")"
+}
+
+func quoteLiteral(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+func quoteLiterals(list []string) string {
 	quoted := make([]string, len(list))
 	for i, v := range list {
-		quoted[i] = quote(v)
+		quoted[i] = quoteLiteral(v)
 	}
-	return fmt.Sprintf("%s IN (%s)", col, strings.Join(quoted, ", "))
+	return strings.Join(quoted, ", ")
 }
@@ -4,6 +4,7 @@
 package seqwall
 
 import (
+	"database/sql"
 	"os"
 	"path/filepath"
 	"strings"
@@ -67,6 +68,76 @@ func TestBuildSchemaCond(t *testing.T) {
 	}
 }
 
+func TestBuildSchemaCond_AllSchemas(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		exclude []string
+		want    string
+	}{
+		{"no-excludes", nil, `(n.nspname NOT LIKE 'pg\_%' AND n.nspname <> 'information_schema')`},
+		{
+			"with-excludes",
+			[]string{"legacy", "o'brien"},
+			`(n.nspname NOT LIKE 'pg\_%' AND n.nspname <> 'information_schema'` +
+				` AND n.nspname NOT IN ('legacy', 'o''brien'))`,
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			w := &StaircaseWorker{schemas: []string{"public"}, allSchemas: true, excludeSchemas: c.exclude}
+			if got := w.buildSchemaCond("n.nspname"); got != c.want {
+				t.Fatalf("buildSchemaCond() got %q, want %q", got, c.want)
+			}
+			if got := w.buildUserSchemaCond("n.nspname"); got != c.want {
+				t.Fatalf("buildUserSchemaCond() got %q, want %q", got, c.want)
+			}
+		})
+	}
+}
+
+func TestQualifiedName(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		parts []string
+		want  string
+	}{
+		{[]string{"public", "users"}, "public.users"},
+		{[]string{"billing", "events", "events_pkey"}, "billing.events.events_pkey"},
+		{[]string{"", "users_email_not_null"}, "users_email_not_null"},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.want, func(t *testing.T) {
+			t.Parallel()
+			got := qualifiedName(c.parts...)
+			if got != c.want {
+				t.Fatalf("qualifiedName() got %q, want %q", got, c.want)
+			}
+		})
+	}
+}
+
+func TestSettingScope(t *testing.T) {
+	t.Parallel()
+
+	if got := settingScope(sql.NullString{}); got != "*" {
+		t.Errorf("settingScope(NULL) got %q, want %q", got, "*")
+	}
+	if got := settingScope(sql.NullString{String: "app", Valid: true}); got != "app" {
+		t.Errorf("settingScope(app) got %q, want %q", got, "app")
+	}
+	if got := qualifiedName(settingScope(sql.NullString{}), "readonly"); got != "*.readonly" {
+		t.Errorf("role-wide setting key got %q, want %q", got, "*.readonly")
+	}
+}
+
 func TestExecuteCommand(t *testing.T) {
 	t.Parallel()
 
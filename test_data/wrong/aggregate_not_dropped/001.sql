@@ -0,0 +1,13 @@
+-- migrate:up
+CREATE TABLE payments (
+  id SERIAL PRIMARY KEY,
+  amount NUMERIC NOT NULL
+);
+
+CREATE FUNCTION numeric_product_step(state NUMERIC, value NUMERIC) RETURNS NUMERIC AS $$
+  SELECT state * value;
+$$ LANGUAGE sql IMMUTABLE;
+
+-- migrate:down
+DROP FUNCTION numeric_product_step(NUMERIC, NUMERIC);
+DROP TABLE payments;
@@ -0,0 +1,10 @@
+-- migrate:up
+CREATE AGGREGATE product(NUMERIC) (
+  SFUNC = numeric_product_step,
+  STYPE = NUMERIC,
+  INITCOND = '1'
+);
+
+-- migrate:down
+-- The aggregate is never dropped.
+SELECT 1;
@@ -0,0 +1,9 @@
+-- migrate:up
+CREATE TABLE customers (
+  id SERIAL PRIMARY KEY,
+  email TEXT NOT NULL,
+  phone TEXT
+);
+
+-- migrate:down
+DROP TABLE customers;
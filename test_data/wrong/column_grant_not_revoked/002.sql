@@ -0,0 +1,6 @@
+-- migrate:up
+GRANT SELECT (id, email) ON customers TO PUBLIC;
+
+-- migrate:down
+-- Only the column-level grant on email is revoked.
+REVOKE SELECT (email) ON customers FROM PUBLIC;
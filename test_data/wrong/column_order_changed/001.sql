@@ -0,0 +1,9 @@
+-- migrate:up
+CREATE TABLE contacts (
+  id SERIAL PRIMARY KEY,
+  phone TEXT,
+  email TEXT NOT NULL
+);
+
+-- migrate:down
+DROP TABLE contacts;
@@ -0,0 +1,6 @@
+-- migrate:up
+ALTER TABLE contacts DROP COLUMN phone;
+
+-- migrate:down
+-- The column comes back at the end of the table instead of between id and email.
+ALTER TABLE contacts ADD COLUMN phone TEXT;
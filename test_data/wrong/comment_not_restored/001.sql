@@ -0,0 +1,10 @@
+-- migrate:up
+CREATE TABLE orders (
+  id SERIAL PRIMARY KEY,
+  total NUMERIC(12, 2) NOT NULL
+);
+
+COMMENT ON COLUMN orders.total IS 'Order total in cents';
+
+-- migrate:down
+DROP TABLE orders;
@@ -0,0 +1,6 @@
+-- migrate:up
+COMMENT ON COLUMN orders.total IS 'Order total including taxes';
+
+-- migrate:down
+-- The previous column comment is not restored.
+COMMENT ON COLUMN orders.total IS NULL;
@@ -0,0 +1,18 @@
+-- migrate:up
+CREATE TABLE warehouses (
+  region TEXT NOT NULL,
+  code TEXT NOT NULL,
+  PRIMARY KEY (region, code)
+);
+
+CREATE TABLE shipments (
+  id SERIAL PRIMARY KEY,
+  region TEXT NOT NULL,
+  code TEXT NOT NULL,
+  CONSTRAINT shipments_warehouse_fkey FOREIGN KEY (region, code)
+    REFERENCES warehouses (region, code) ON DELETE RESTRICT
+);
+
+-- migrate:down
+DROP TABLE shipments;
+DROP TABLE warehouses;
@@ -0,0 +1,12 @@
+-- migrate:up
+ALTER TABLE shipments DROP CONSTRAINT shipments_warehouse_fkey;
+ALTER TABLE shipments ADD CONSTRAINT shipments_warehouse_fkey
+  FOREIGN KEY (region, code) REFERENCES warehouses (region, code)
+  ON DELETE CASCADE DEFERRABLE INITIALLY DEFERRED;
+
+-- migrate:down
+-- The original ON DELETE RESTRICT, non-deferrable constraint is never restored.
+ALTER TABLE shipments DROP CONSTRAINT shipments_warehouse_fkey;
+ALTER TABLE shipments ADD CONSTRAINT shipments_warehouse_fkey
+  FOREIGN KEY (region, code) REFERENCES warehouses (region, code)
+  ON DELETE CASCADE;
@@ -0,0 +1,11 @@
+-- migrate:up
+CREATE TABLE products (
+  id SERIAL PRIMARY KEY,
+  price NUMERIC NOT NULL,
+  CONSTRAINT products_price_positive CHECK (price > 0)
+);
+
+COMMENT ON CONSTRAINT products_price_positive ON products IS 'Prices are strictly positive';
+
+-- migrate:down
+DROP TABLE products;
@@ -0,0 +1,6 @@
+-- migrate:up
+COMMENT ON CONSTRAINT products_price_positive ON products IS 'Zero prices are rejected';
+
+-- migrate:down
+-- The original constraint comment is never restored.
+SELECT 1;
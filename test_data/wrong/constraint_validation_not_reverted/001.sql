@@ -0,0 +1,11 @@
+-- migrate:up
+CREATE TABLE orders (
+  id SERIAL PRIMARY KEY,
+  quantity INT NOT NULL
+);
+
+ALTER TABLE orders ADD CONSTRAINT orders_quantity_positive
+  CHECK (quantity > 0) NOT VALID;
+
+-- migrate:down
+DROP TABLE orders;
@@ -0,0 +1,6 @@
+-- migrate:up
+ALTER TABLE orders VALIDATE CONSTRAINT orders_quantity_positive;
+
+-- migrate:down
+-- The constraint stays validated; it is never re-created as NOT VALID.
+SELECT 1;
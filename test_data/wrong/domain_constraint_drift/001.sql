@@ -0,0 +1,12 @@
+-- migrate:up
+CREATE DOMAIN email AS TEXT
+  CONSTRAINT email_format CHECK (VALUE ~ '^[^@]+@[^@]+$');
+
+CREATE TABLE users (
+  id SERIAL PRIMARY KEY,
+  email email NOT NULL
+);
+
+-- migrate:down
+DROP TABLE users;
+DROP DOMAIN email;
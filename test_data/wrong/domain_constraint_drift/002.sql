@@ -0,0 +1,8 @@
+-- migrate:up
+ALTER DOMAIN email DROP CONSTRAINT email_format;
+ALTER DOMAIN email
+  ADD CONSTRAINT email_format CHECK (VALUE ~ '^[^@]+@[^@]+\.[^@]+$');
+
+-- migrate:down
+-- The original constraint expression is not restored.
+SELECT 1;
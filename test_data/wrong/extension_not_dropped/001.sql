@@ -0,0 +1,11 @@
+-- migrate:up
+CREATE SCHEMA extensions;
+
+CREATE TABLE users (
+  id SERIAL PRIMARY KEY,
+  email TEXT NOT NULL
+);
+
+-- migrate:down
+DROP TABLE users;
+DROP SCHEMA extensions;
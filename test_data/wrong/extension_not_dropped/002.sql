@@ -0,0 +1,6 @@
+-- migrate:up
+CREATE EXTENSION IF NOT EXISTS citext SCHEMA extensions;
+
+-- migrate:down
+-- The extension is never dropped.
+SELECT 1;
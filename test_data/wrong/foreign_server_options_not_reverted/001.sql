@@ -0,0 +1,10 @@
+-- migrate:up
+CREATE EXTENSION IF NOT EXISTS postgres_fdw;
+
+CREATE SERVER reporting
+  FOREIGN DATA WRAPPER postgres_fdw
+  OPTIONS (host 'localhost', dbname 'reporting');
+
+-- migrate:down
+DROP SERVER reporting;
+DROP EXTENSION IF EXISTS postgres_fdw;
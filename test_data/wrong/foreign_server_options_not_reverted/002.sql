@@ -0,0 +1,6 @@
+-- migrate:up
+ALTER SERVER reporting OPTIONS (ADD fetch_size '1000');
+
+-- migrate:down
+-- The added server option is not dropped.
+SELECT 1;
@@ -0,0 +1,8 @@
+-- migrate:up
+CREATE TABLE accounts (
+  id SERIAL PRIMARY KEY,
+  balance NUMERIC(12, 2) NOT NULL DEFAULT 0
+);
+
+-- migrate:down
+DROP TABLE accounts;
@@ -0,0 +1,7 @@
+-- migrate:up
+CREATE FUNCTION account_balance(account_id INTEGER) RETURNS NUMERIC AS $$
+  SELECT balance FROM accounts WHERE id = account_id;
+$$ LANGUAGE sql STABLE;
+
+-- migrate:down
+DROP FUNCTION account_balance(INTEGER);
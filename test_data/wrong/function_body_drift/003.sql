@@ -0,0 +1,8 @@
+-- migrate:up
+CREATE OR REPLACE FUNCTION account_balance(account_id INTEGER) RETURNS NUMERIC AS $$
+  SELECT COALESCE(balance, 0) FROM accounts WHERE id = account_id;
+$$ LANGUAGE sql STABLE;
+
+-- migrate:down
+-- The previous function body is not restored.
+SELECT 1;
@@ -0,0 +1,10 @@
+-- migrate:up
+CREATE TABLE page_views (
+  id BIGSERIAL PRIMARY KEY,
+  viewed_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX page_views_viewed_at_idx ON page_views (viewed_at);
+
+-- migrate:down
+DROP TABLE page_views;
@@ -0,0 +1,6 @@
+-- migrate:up
+ALTER TABLE page_views CLUSTER ON page_views_viewed_at_idx;
+
+-- migrate:down
+-- The index stays marked for CLUSTER; SET WITHOUT CLUSTER is missing.
+SELECT 1;
@@ -0,0 +1,11 @@
+-- migrate:up
+CREATE ROLE app_owner NOLOGIN;
+
+CREATE TABLE invoices (
+  id SERIAL PRIMARY KEY,
+  amount NUMERIC(12, 2) NOT NULL
+);
+
+-- migrate:down
+DROP TABLE invoices;
+DROP ROLE app_owner;
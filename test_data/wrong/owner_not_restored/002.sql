@@ -0,0 +1,6 @@
+-- migrate:up
+ALTER TABLE invoices OWNER TO app_owner;
+
+-- migrate:down
+-- The previous owner is not restored.
+SELECT 1;
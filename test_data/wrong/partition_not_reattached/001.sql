@@ -0,0 +1,15 @@
+-- migrate:up
+CREATE TABLE measurements (
+  id BIGSERIAL,
+  recorded_at DATE NOT NULL,
+  value NUMERIC NOT NULL
+) PARTITION BY RANGE (recorded_at);
+
+CREATE TABLE measurements_2024 PARTITION OF measurements
+  FOR VALUES FROM ('2024-01-01') TO ('2025-01-01');
+
+CREATE TABLE measurements_2025 PARTITION OF measurements
+  FOR VALUES FROM ('2025-01-01') TO ('2026-01-01');
+
+-- migrate:down
+DROP TABLE measurements;
@@ -0,0 +1,6 @@
+-- migrate:up
+ALTER TABLE measurements DETACH PARTITION measurements_2024;
+
+-- migrate:down
+-- The partition is left detached as a standalone table.
+SELECT 1;
@@ -0,0 +1,17 @@
+-- migrate:up
+CREATE TABLE orders (
+  id SERIAL PRIMARY KEY,
+  total NUMERIC(12, 2) NOT NULL
+);
+
+CREATE TABLE refunds (
+  id SERIAL PRIMARY KEY,
+  order_id INTEGER NOT NULL REFERENCES orders(id)
+);
+
+CREATE PUBLICATION analytics FOR TABLE orders;
+
+-- migrate:down
+DROP PUBLICATION analytics;
+DROP TABLE refunds;
+DROP TABLE orders;
@@ -0,0 +1,6 @@
+-- migrate:up
+ALTER PUBLICATION analytics ADD TABLE refunds;
+
+-- migrate:down
+-- The table stays in the publication.
+SELECT 1;
@@ -0,0 +1,9 @@
+-- migrate:up
+CREATE TABLE documents (
+  id SERIAL PRIMARY KEY,
+  tenant_id INTEGER NOT NULL,
+  body TEXT NOT NULL
+);
+
+-- migrate:down
+DROP TABLE documents;
@@ -0,0 +1,9 @@
+-- migrate:up
+ALTER TABLE documents ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY documents_tenant_isolation ON documents
+  USING (tenant_id = current_setting('app.tenant_id')::INTEGER);
+
+-- migrate:down
+-- Row level security stays enabled after the policy is dropped.
+DROP POLICY documents_tenant_isolation ON documents;
@@ -0,0 +1,8 @@
+-- migrate:up
+CREATE TABLE audit_log (
+  id SERIAL PRIMARY KEY,
+  message TEXT NOT NULL
+);
+
+-- migrate:down
+DROP TABLE audit_log;
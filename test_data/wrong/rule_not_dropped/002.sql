@@ -0,0 +1,8 @@
+-- migrate:up
+CREATE RULE audit_log_no_delete AS
+  ON DELETE TO audit_log
+  DO INSTEAD NOTHING;
+
+-- migrate:down
+-- The rule is never dropped.
+SELECT 1;
@@ -0,0 +1,8 @@
+-- migrate:up
+CREATE TABLE events (
+  id SERIAL PRIMARY KEY,
+  payload JSONB NOT NULL
+);
+
+-- migrate:down
+DROP TABLE events;
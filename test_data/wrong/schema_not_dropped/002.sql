@@ -0,0 +1,6 @@
+-- migrate:up
+CREATE SCHEMA audit;
+
+-- migrate:down
+-- The schema is never dropped.
+SELECT 1;
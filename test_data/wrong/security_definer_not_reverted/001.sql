@@ -0,0 +1,13 @@
+-- migrate:up
+CREATE TABLE secrets (
+  id SERIAL PRIMARY KEY,
+  value TEXT NOT NULL
+);
+
+CREATE FUNCTION secrets_count() RETURNS BIGINT AS $$
+  SELECT count(*) FROM secrets;
+$$ LANGUAGE sql STABLE;
+
+-- migrate:down
+DROP FUNCTION secrets_count();
+DROP TABLE secrets;
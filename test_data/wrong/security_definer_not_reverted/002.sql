@@ -0,0 +1,6 @@
+-- migrate:up
+ALTER FUNCTION secrets_count() SECURITY DEFINER SET search_path = public;
+
+-- migrate:down
+-- The function keeps running as its owner; SECURITY INVOKER is never restored.
+ALTER FUNCTION secrets_count() RESET search_path;
@@ -0,0 +1,8 @@
+-- migrate:up
+CREATE TABLE invoices (
+  id SERIAL PRIMARY KEY,
+  total NUMERIC NOT NULL
+);
+
+-- migrate:down
+DROP TABLE invoices;
@@ -0,0 +1,6 @@
+-- migrate:up
+ALTER SEQUENCE invoices_id_seq OWNED BY NONE;
+
+-- migrate:down
+-- The sequence is never re-attached to invoices.id.
+SELECT 1;
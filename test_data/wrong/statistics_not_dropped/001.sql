@@ -0,0 +1,9 @@
+-- migrate:up
+CREATE TABLE addresses (
+  id SERIAL PRIMARY KEY,
+  city TEXT NOT NULL,
+  zip TEXT NOT NULL
+);
+
+-- migrate:down
+DROP TABLE addresses;
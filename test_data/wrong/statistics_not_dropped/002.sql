@@ -0,0 +1,8 @@
+-- migrate:up
+CREATE STATISTICS addresses_city_zip (dependencies) ON city, zip FROM addresses;
+
+CREATE TEXT SEARCH CONFIGURATION addresses_search (COPY = pg_catalog.simple);
+
+-- migrate:down
+-- The extended statistics object is never dropped.
+DROP TEXT SEARCH CONFIGURATION addresses_search;
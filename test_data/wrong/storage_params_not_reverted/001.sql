@@ -0,0 +1,8 @@
+-- migrate:up
+CREATE TABLE events (
+  id BIGSERIAL PRIMARY KEY,
+  payload TEXT NOT NULL
+);
+
+-- migrate:down
+DROP TABLE events;
@@ -0,0 +1,7 @@
+-- migrate:up
+ALTER TABLE events SET (fillfactor = 70, autovacuum_vacuum_scale_factor = 0.01);
+ALTER TABLE events ALTER COLUMN payload SET STATISTICS 500;
+
+-- migrate:down
+-- Only the statistics target is reverted, the storage parameters stay.
+ALTER TABLE events ALTER COLUMN payload SET STATISTICS -1;
@@ -0,0 +1,23 @@
+-- migrate:up
+CREATE TABLE accounts (
+  id SERIAL PRIMARY KEY,
+  balance NUMERIC NOT NULL DEFAULT 0,
+  updated_at TIMESTAMPTZ
+);
+
+CREATE FUNCTION accounts_touch() RETURNS trigger AS $$
+BEGIN
+  NEW.updated_at := now();
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER accounts_touch
+  BEFORE UPDATE OF balance ON accounts
+  FOR EACH ROW
+  WHEN (OLD.balance IS DISTINCT FROM NEW.balance)
+  EXECUTE FUNCTION accounts_touch();
+
+-- migrate:down
+DROP TABLE accounts;
+DROP FUNCTION accounts_touch();
@@ -0,0 +1,14 @@
+-- migrate:up
+DROP TRIGGER accounts_touch ON accounts;
+CREATE TRIGGER accounts_touch
+  BEFORE UPDATE ON accounts
+  FOR EACH ROW
+  EXECUTE FUNCTION accounts_touch();
+
+-- migrate:down
+-- The trigger is recreated without its column list and WHEN clause.
+DROP TRIGGER accounts_touch ON accounts;
+CREATE TRIGGER accounts_touch
+  BEFORE UPDATE ON accounts
+  FOR EACH ROW
+  EXECUTE FUNCTION accounts_touch();
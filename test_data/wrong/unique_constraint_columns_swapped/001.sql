@@ -0,0 +1,10 @@
+-- migrate:up
+CREATE TABLE memberships (
+  id SERIAL PRIMARY KEY,
+  team_id INT NOT NULL,
+  user_id INT NOT NULL,
+  CONSTRAINT memberships_team_user_key UNIQUE (team_id, user_id)
+);
+
+-- migrate:down
+DROP TABLE memberships;
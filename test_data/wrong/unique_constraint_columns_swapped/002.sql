@@ -0,0 +1,10 @@
+-- migrate:up
+ALTER TABLE memberships DROP CONSTRAINT memberships_team_user_key;
+ALTER TABLE memberships ADD CONSTRAINT memberships_team_user_key
+  UNIQUE (team_id, user_id) DEFERRABLE;
+
+-- migrate:down
+-- The constraint is restored with its columns swapped.
+ALTER TABLE memberships DROP CONSTRAINT memberships_team_user_key;
+ALTER TABLE memberships ADD CONSTRAINT memberships_team_user_key
+  UNIQUE (user_id, team_id);